@@ -0,0 +1,233 @@
+package tnef
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// Appointment is a calendar item, meeting request, or response to a meeting
+// request; see Data.Appointment.
+type Appointment struct {
+	// Method is the iCalendar method that corresponds to the message class:
+	// "PUBLISH" for appointments, "REQUEST" for meeting requests, "CANCEL"
+	// for cancellations, and "REPLY" for responses.
+	Method string
+
+	// UID is the global object ID of the meeting in hex, which is the same
+	// for the request and all the responses and updates. It's the MessageID
+	// if the global object ID isn't set.
+	UID      string
+	Sequence int
+
+	Subject     string
+	Description string
+	Location    string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	BusyStatus  int // One of the Busy* constants.
+
+	// Organizer of the meeting; for responses the attendee who responded is
+	// in Attendees, with their response in TrackStatus.
+	Organizer         Address
+	Attendees         []Recipient
+	ResponseRequested bool
+
+	// Recurrence is the recurrence pattern (PidLidAppointmentRecur), if
+	// Recurring is set.
+	Recurring  bool
+	Recurrence []byte
+
+	SentAt time.Time
+
+	// Problems found by WriteICalendar that didn't prevent writing the
+	// appointment, such as a recurrence pattern that can't be written as an
+	// RRULE.
+	Warnings []error
+
+	recurrenceID time.Time // Original start of a modified occurrence.
+	codepage     int       // Code page of 8-bit strings in the recurrence pattern.
+}
+
+// Busy status of the time of an appointment, as stored in
+// Appointment.BusyStatus.
+const (
+	BusyFree             = 0x0000
+	BusyTentative        = 0x0001
+	BusyBusy             = 0x0002
+	BusyOutOfOffice      = 0x0003
+	BusyWorkingElsewhere = 0x0004
+)
+
+// Named properties in PSETIDAppointment, PSETIDCommon, and PSETIDMeeting; see
+// MS-OXPROPS.
+const (
+	lidAppointmentSequence = 0x8201
+	lidBusyStatus          = 0x8205
+	lidLocation            = 0x8208
+	lidAppointmentStart    = 0x820D
+	lidAppointmentEnd      = 0x820E
+	lidAppointmentSubType  = 0x8215 // All-day event.
+	lidAppointmentRecur    = 0x8216
+	lidRecurring           = 0x8223
+	lidCommonStart         = 0x8516
+	lidCommonEnd           = 0x8517
+	lidGlobalObjectID      = 0x0003
+	lidCleanGlobalObjectID = 0x0023
+)
+
+// Appointment gets the calendar information of an appointment or meeting
+// request, or nil if the message isn't one (based on the MessageClass).
+//
+// The information is taken from the named MAPI properties, falling back to
+// the legacy TNEF attributes such as ATTDATESTART and ATTOWNER.
+func (d *Data) Appointment() *Appointment {
+	method := calendarMethod(d.MessageClass)
+	if method == "" {
+		return nil
+	}
+
+	named := func(set GUID, id int32) MAPIAttribute {
+		attr, _ := findNamed(d.Attributes, set, id)
+		return attr
+	}
+
+	text, _ := d.TextBody()
+	a := &Appointment{
+		Method:      method,
+		Subject:     d.Subject,
+		Description: text,
+		Start:       d.appointmentTime(lidAppointmentStart, lidCommonStart, ATTDATESTART),
+		End:         d.appointmentTime(lidAppointmentEnd, lidCommonEnd, ATTDATEEND),
+		BusyStatus:  BusyBusy,
+		SentAt:      d.SentAt,
+		codepage:    d.Codepage,
+	}
+	a.Sequence, _ = named(PSETIDAppointment, lidAppointmentSequence).Int()
+	a.Location, _ = named(PSETIDAppointment, lidLocation).StringValue()
+	a.AllDay, _ = named(PSETIDAppointment, lidAppointmentSubType).Bool()
+	a.Recurring, _ = named(PSETIDAppointment, lidRecurring).Bool()
+	if a.Recurring {
+		a.Recurrence, _ = named(PSETIDAppointment, lidAppointmentRecur).Binary()
+	}
+	if busy, err := named(PSETIDAppointment, lidBusyStatus).Int(); err == nil {
+		a.BusyStatus = busy
+	}
+
+	for _, id := range []int32{lidGlobalObjectID, lidCleanGlobalObjectID} {
+		if b, err := named(PSETIDMeeting, id).Binary(); err == nil && len(b) > 0 {
+			a.UID = strings.ToUpper(hex.EncodeToString(b))
+			break
+		}
+	}
+	if a.UID == "" {
+		a.UID = d.MessageID
+	}
+
+	if attr, ok := findAttr(d.Attributes, MAPIResponseRequested); ok {
+		a.ResponseRequested, _ = attr.Bool()
+	} else if buf := d.legacyAttr(ATTREQUESTRES, atpShort); buf != nil {
+		res, _ := buf.int(2)
+		a.ResponseRequested = res != 0
+	}
+
+	// ATTOWNER is the organizer for both requests and responses.
+	if buf := d.legacyAttr(ATTOWNER, atpByte); buf != nil {
+		a.Organizer, _ = decodeOwner(buf, d.Codepage)
+	}
+	if method == "REPLY" {
+		// The response is sent to the organizer.
+		for _, r := range d.Recipients {
+			if a.Organizer == (Address{}) && r.Type == RecipientTo {
+				a.Organizer = r.Address
+			}
+		}
+
+		attendee := Recipient{Address: d.From, Type: RecipientTo, TrackStatus: replyStatus(d.MessageClass)}
+		if d.From.AddressType == "SMTP" {
+			attendee.SMTPAddress = d.From.Email
+		}
+		a.Attendees = []Recipient{attendee}
+	} else {
+		if a.Organizer == (Address{}) {
+			a.Organizer = d.From
+		}
+		a.Attendees = d.Recipients
+	}
+
+	return a
+}
+
+// appointmentTime gets the start or end time from the appointment property,
+// the common property, or the legacy attribute.
+func (d *Data) appointmentTime(lid, commonLID int32, legacy int) time.Time {
+	if attr, ok := findNamed(d.Attributes, PSETIDAppointment, lid); ok {
+		if t, err := attr.Time(); err == nil {
+			return t
+		}
+	}
+	if attr, ok := findNamed(d.Attributes, PSETIDCommon, commonLID); ok {
+		if t, err := attr.Time(); err == nil {
+			return t
+		}
+	}
+	if buf := d.legacyAttr(legacy, atpDate); buf != nil {
+		t, _ := decodeDTR(buf)
+		return t
+	}
+	return time.Time{}
+}
+
+// calendarMethod gets the iCalendar method for the message class, or "" if
+// it's not a calendar item.
+func calendarMethod(class string) string {
+	class = strings.ToLower(class)
+	switch {
+	case class == "ipm.appointment" || strings.HasPrefix(class, "ipm.appointment."):
+		return "PUBLISH"
+	case strings.HasPrefix(class, "ipm.schedule.meeting.request"):
+		return "REQUEST"
+	case strings.HasPrefix(class, "ipm.schedule.meeting.canceled"):
+		return "CANCEL"
+	case strings.HasPrefix(class, "ipm.schedule.meeting.resp."):
+		return "REPLY"
+	}
+	return ""
+}
+
+// replyStatus gets the response from the class of a meeting response, e.g.
+// IPM.Schedule.Meeting.Resp.Pos for an accepted meeting.
+func replyStatus(class string) int {
+	switch strings.ToLower(class[strings.LastIndexByte(class, '.')+1:]) {
+	case "pos":
+		return ResponseAccepted
+	case "neg":
+		return ResponseDeclined
+	case "tent":
+		return ResponseTentative
+	}
+	return ResponseNone
+}
+
+// decodeOwner decodes the ATTOWNER attribute: the display name and the address
+// as "TYPE:address", both preceded by their length and in the code page cp.
+func decodeOwner(buf *buffer, cp int) (Address, error) {
+	var addr Address
+	var s [2]string
+	for i := range s {
+		n, err := buf.int(2)
+		if err != nil {
+			return addr, err
+		}
+		b, err := buf.bytes(n)
+		if err != nil {
+			return addr, err
+		}
+		s[i] = legacyString(cp, b)
+	}
+
+	addr.Name, addr.Email = s[0], s[1]
+	addr.AddressType, addr.Email = splitAddress(addr.Email)
+	return addr, nil
+}
@@ -0,0 +1,227 @@
+package tnef
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/teamwork/test"
+)
+
+func TestAppointment(t *testing.T) {
+	d, err := Decode(test.Read(t, "./testdata", "multi-name-property.tnef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := d.Appointment()
+	if a == nil {
+		t.Fatal("Appointment is nil")
+	}
+	if a.Method != "PUBLISH" {
+		t.Errorf("wrong Method: %q", a.Method)
+	}
+	if a.Subject != "Pfingstmontag" {
+		t.Errorf("wrong Subject: %q", a.Subject)
+	}
+	if a.Location != "Deutschland" {
+		t.Errorf("wrong Location: %q", a.Location)
+	}
+	if !a.AllDay {
+		t.Error("AllDay not set")
+	}
+	if a.BusyStatus != BusyFree {
+		t.Errorf("wrong BusyStatus: %d", a.BusyStatus)
+	}
+	if want := time.Date(2003, 6, 8, 22, 0, 0, 0, time.UTC); !a.Start.Equal(want) {
+		t.Errorf("wrong Start: %v", a.Start)
+	}
+	if want := time.Date(2003, 6, 9, 22, 0, 0, 0, time.UTC); !a.End.Equal(want) {
+		t.Errorf("wrong End: %v", a.End)
+	}
+
+	// The description is converted from the code page of the message.
+	d = &Data{MessageClass: "IPM.Appointment", Codepage: 1251, Body: []byte("\xcf\xf0\xe8\xe2\xe5\xf2\x00")}
+	if a := d.Appointment(); a == nil || a.Description != "Привет" {
+		t.Errorf("wrong Description: %#v", a)
+	}
+
+	if d, err = Decode(test.Read(t, "./testdata", "body.tnef")); err != nil {
+		t.Fatal(err)
+	}
+	if a := d.Appointment(); a != nil {
+		t.Errorf("Appointment for %q is not nil: %#v", d.MessageClass, a)
+	}
+}
+
+func TestAppointmentLegacy(t *testing.T) {
+	dtr := func(y, m, d, h int) []byte {
+		var b []byte
+		for _, v := range []int{y, m, d, h, 0, 0, 0} {
+			b = append(b, le16(uint16(v))...)
+		}
+		return b
+	}
+	owner := append(append(le16(6), "Alice\x00"...), append(le16(23), "SMTP:alice@example.com\x00"...)...)
+
+	d := &Data{
+		MessageClass: "IPM.Schedule.Meeting.Request",
+		Subject:      "Lunch",
+		legacy: []tnefObject{
+			// Original message class, which has the same name as
+			// ATTDATESTART.
+			{Name: ATTDATESTART, Type: atpWord, Data: []byte("IPM.Note\x00")},
+			{Name: ATTDATESTART, Type: atpDate, Data: dtr(2020, 3, 4, 12)},
+			{Name: ATTDATEEND, Type: atpDate, Data: dtr(2020, 3, 4, 13)},
+			{Name: ATTREQUESTRES, Type: atpShort, Data: []byte{1, 0}},
+			{Name: ATTOWNER, Type: atpByte, Data: owner},
+		},
+	}
+
+	a := d.Appointment()
+	if a.Method != "REQUEST" {
+		t.Errorf("wrong Method: %q", a.Method)
+	}
+	if want := time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC); !a.Start.Equal(want) {
+		t.Errorf("wrong Start: %v", a.Start)
+	}
+	if want := time.Date(2020, 3, 4, 13, 0, 0, 0, time.UTC); !a.End.Equal(want) {
+		t.Errorf("wrong End: %v", a.End)
+	}
+	if !a.ResponseRequested {
+		t.Error("ResponseRequested not set")
+	}
+	if want := (Address{Name: "Alice", AddressType: "SMTP", Email: "alice@example.com"}); a.Organizer != want {
+		t.Errorf("wrong Organizer: %#v", a.Organizer)
+	}
+}
+
+func TestWriteICalendar(t *testing.T) {
+	t.Run("appointment", func(t *testing.T) {
+		d, err := Decode(test.Read(t, "./testdata", "triples.tnef"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		buf := new(bytes.Buffer)
+		if err := d.Appointment().WriteICalendar(buf); err != nil {
+			t.Fatal(err)
+		}
+
+		want := strings.Replace(`BEGIN:VCALENDAR
+PRODID:-//Teamwork//tnef//EN
+VERSION:2.0
+METHOD:PUBLISH
+BEGIN:VEVENT
+UID:C326F5735704184D96EBD387444C618B
+DTSTAMP:20030523T132617Z
+SEQUENCE:0
+DTSTART:20030523T140000Z
+DTEND:20030523T150000Z
+SUMMARY:Sample Summary
+LOCATION:Sample Location
+DESCRIPTION:Sample description\n
+ORGANIZER;CN=Martin Rakhmanoff:mailto:rakhmanoff@sundance.spb.ru
+TRANSP:OPAQUE
+X-MICROSOFT-CDO-BUSYSTATUS:OOF
+END:VEVENT
+END:VCALENDAR
+`, "\n", "\r\n", -1)
+		if got := buf.String(); got != want {
+			t.Errorf("\ngot:\n%s\nwant:\n%s", got, want)
+		}
+	})
+
+	t.Run("request", func(t *testing.T) {
+		a := &Appointment{
+			Method:            "REQUEST",
+			UID:               "040000008200E00074C5B7101A82E008",
+			Subject:           "Planning, part 2",
+			Start:             time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC),
+			End:               time.Date(2020, 3, 4, 13, 0, 0, 0, time.UTC),
+			Organizer:         Address{Name: "Alice", AddressType: "SMTP", Email: "alice@example.com"},
+			ResponseRequested: true,
+			BusyStatus:        BusyBusy,
+			SentAt:            time.Date(2020, 3, 1, 9, 0, 0, 0, time.UTC),
+			Attendees: []Recipient{
+				{Address: Address{Name: "Bob; Jr.", AddressType: "EX", Email: "/O=EXAMPLE/CN=BOB"},
+					SMTPAddress: "bob@example.com", Type: RecipientTo, TrackStatus: ResponseAccepted},
+				{Address: Address{Name: "Room 1", AddressType: "EX", Email: "/O=EXAMPLE/CN=ROOM1"},
+					Type: RecipientBcc},
+			},
+		}
+
+		buf := new(bytes.Buffer)
+		if err := a.WriteICalendar(buf); err != nil {
+			t.Fatal(err)
+		}
+
+		want := strings.Replace(`BEGIN:VCALENDAR
+PRODID:-//Teamwork//tnef//EN
+VERSION:2.0
+METHOD:REQUEST
+BEGIN:VEVENT
+UID:040000008200E00074C5B7101A82E008
+DTSTAMP:20200301T090000Z
+SEQUENCE:0
+DTSTART:20200304T120000Z
+DTEND:20200304T130000Z
+SUMMARY:Planning\, part 2
+ORGANIZER;CN=Alice:mailto:alice@example.com
+ATTENDEE;CN="Bob; Jr.";ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED;RSVP=TRUE:mai
+ lto:bob@example.com
+ATTENDEE;CN=Room 1;ROLE=NON-PARTICIPANT;CUTYPE=RESOURCE;PARTSTAT=NEEDS-ACTI
+ ON;RSVP=TRUE:invalid:nomail
+TRANSP:OPAQUE
+X-MICROSOFT-CDO-BUSYSTATUS:BUSY
+END:VEVENT
+END:VCALENDAR
+`, "\n", "\r\n", -1)
+		if got := buf.String(); got != want {
+			t.Errorf("\ngot:\n%s\nwant:\n%s", got, want)
+		}
+	})
+}
+
+func TestReplyAppointment(t *testing.T) {
+	d := &Data{
+		MessageClass: "IPM.Schedule.Meeting.Resp.Tent",
+		From:         Address{Name: "Bob", AddressType: "SMTP", Email: "bob@example.com"},
+		Recipients: []Recipient{
+			{Address: Address{Name: "Alice", AddressType: "SMTP", Email: "alice@example.com"}, Type: RecipientTo},
+		},
+	}
+
+	a := d.Appointment()
+	if a.Method != "REPLY" {
+		t.Errorf("wrong Method: %q", a.Method)
+	}
+	if a.Organizer != d.Recipients[0].Address {
+		t.Errorf("wrong Organizer: %#v", a.Organizer)
+	}
+	if len(a.Attendees) != 1 || a.Attendees[0].Address != d.From || a.Attendees[0].TrackStatus != ResponseTentative {
+		t.Errorf("wrong Attendees: %#v", a.Attendees)
+	}
+}
+
+func TestFoldLine(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"SUMMARY:short", "SUMMARY:short\r\n"},
+		{strings.Repeat("a", 75), strings.Repeat("a", 75) + "\r\n"},
+		{strings.Repeat("a", 76), strings.Repeat("a", 75) + "\r\n a\r\n"},
+		// Don't split the é.
+		{strings.Repeat("a", 74) + "éa", strings.Repeat("a", 74) + "\r\n éa\r\n"},
+		// Invalid UTF-8 can be split anywhere.
+		{strings.Repeat("\x80", 200), strings.Repeat("\x80", 75) + "\r\n " +
+			strings.Repeat("\x80", 74) + "\r\n " + strings.Repeat("\x80", 51) + "\r\n"},
+	}
+
+	for _, tt := range tests {
+		if got := foldLine(tt.in); got != tt.want {
+			t.Errorf("\ngot:  %q\nwant: %q", got, tt.want)
+		}
+	}
+}
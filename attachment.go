@@ -0,0 +1,185 @@
+package tnef
+
+import (
+	"bytes"
+	"mime"
+	"strings"
+	"unicode"
+)
+
+// Interface identifier of embedded messages (IID_IMessage); this is stored
+// before the data of MAPIAttachDataObj properties.
+var iidIMessage = []byte{0x07, 0x03, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}
+
+// Extensions for common MIME types; mime.ExtensionsByType depends on the
+// system's MIME database, and often returns more than one extension.
+var mimeExtensions = map[string]string{
+	"application/msword":       ".doc",
+	"application/octet-stream": ".bin",
+	"application/pdf":          ".pdf",
+	"application/rtf":          ".rtf",
+	"application/vnd.ms-excel": ".xls",
+	"application/zip":          ".zip",
+	"audio/mp3":                ".mp3",
+	"audio/mpeg":               ".mp3",
+	"audio/wav":                ".wav",
+	"image/bmp":                ".bmp",
+	"image/gif":                ".gif",
+	"image/jpeg":               ".jpg",
+	"image/png":                ".png",
+	"image/tiff":               ".tif",
+	"message/rfc822":           ".eml",
+	"text/calendar":            ".ics",
+	"text/html":                ".htm",
+	"text/plain":               ".txt",
+	"text/rtf":                 ".rtf",
+	"text/vcard":               ".vcf",
+	"text/x-vcard":             ".vcf",
+}
+
+// Filename gets the best available filename for the attachment.
+//
+// It uses the first non-empty name from:
+//
+//   - the long filename (MAPIAttachLongFilename),
+//   - the MAPI filename (MAPIAttachFilename), which is usually in the 8.3
+//     format,
+//   - the TNEF title (ATTATTACHTITLE), which is also usually 8.3,
+//   - the transport filename (ATTATTACHTRANSPORTFILENAME).
+//
+// If none of these are present "attachment" is used, with an extension based on
+// the MAPIAttachExtension property or the MIME type.
+//
+// The name is made safe to use as a filename: any directory is removed, as are
+// control characters. It's never empty.
+func (a *Attachment) Filename() string {
+	var mapiName, ext string
+	for _, attr := range a.Attributes {
+		switch attr.Name {
+		case MAPIAttachFilename:
+			mapiName, _ = attr.StringValue()
+		case MAPIAttachExtension:
+			ext, _ = attr.StringValue()
+		}
+	}
+
+	for _, name := range []string{a.LongFilename, mapiName, a.Title, a.transportFilename} {
+		if name = safeFilename(name); name != "" {
+			return name
+		}
+	}
+
+	if ext == "" {
+		ext = mimeExtension(a.MimeType)
+	}
+	if ext = safeFilename(ext); ext != "" && ext[0] != '.' {
+		ext = "." + ext
+	}
+	return "attachment" + ext
+}
+
+// mimeExtension gets the extension for a MIME type, or ".dat" if it's not
+// known.
+func mimeExtension(mimeType string) string {
+	mimeType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return ".dat"
+	}
+	if ext, ok := mimeExtensions[mimeType]; ok {
+		return ext
+	}
+	if exts, _ := mime.ExtensionsByType(mimeType); len(exts) > 0 {
+		return exts[0]
+	}
+	return ".dat"
+}
+
+// safeFilename removes any directory from name, as well as characters that
+// aren't safe in filenames.
+func safeFilename(name string) string {
+	if i := strings.LastIndexAny(name, `/\`); i > -1 {
+		name = name[i+1:]
+	}
+
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || strings.ContainsRune(`<>:"|?*`, r) {
+			return -1
+		}
+		return r
+	}, name)
+
+	name = strings.TrimSpace(name)
+	if strings.Trim(name, ".") == "" {
+		return ""
+	}
+	return name
+}
+
+// attachDataObj gets the attachment data from the MAPIAttachDataObj property if
+// it wasn't in ATTATTACHDATA, and decodes embedded messages.
+//
+// The property is either binary (the same as ATTATTACHDATA), or an object:
+// an interface identifier followed by the data. For embedded messages the data
+// is another TNEF stream.
+func (d *Decoder) attachDataObj(a *Attachment) error {
+	for _, attr := range a.Attributes {
+		if attr.Name != MAPIAttachDataObj {
+			continue
+		}
+
+		if attr.Type != szmapiObject || len(attr.Data) < 16 {
+			if len(a.Data) == 0 {
+				a.Data = attr.Data
+			}
+			continue
+		}
+
+		data := attr.Data[16:]
+		if !bytes.Equal(attr.Data[:16], iidIMessage) && !bytes.HasPrefix(data, le32(tnefSignature)) {
+			// Some other object, such as an OLE document.
+			if len(a.Data) == 0 {
+				a.Data = data
+			}
+			continue
+		}
+
+		return d.decodeEmbedded(a, data)
+	}
+	return nil
+}
+
+// decodeEmbedded decodes the TNEF stream of an embedded message in to
+// a.Embedded. Warnings from the embedded message are recorded in both
+// a.Embedded.Warnings and the warnings of d, so that they're also in the
+// Warnings of the top-level message.
+func (d *Decoder) decodeEmbedded(a *Attachment, data []byte) error {
+	maxDepth := d.Options.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	if maxDepth < 0 {
+		return nil
+	}
+	if d.depth >= maxDepth {
+		if d.Options.Strict {
+			return ErrMaxDepth
+		}
+		d.warnings = append(d.warnings, ErrMaxDepth)
+		return nil
+	}
+
+	sub := NewDecoder(bytes.NewReader(data))
+	sub.Options = d.Options
+	sub.depth = d.depth + 1
+
+	var err error
+	a.Embedded, err = decode(sub)
+	d.warnings = append(d.warnings, sub.warnings...)
+	if err != nil {
+		if d.Options.Strict {
+			return err
+		}
+		d.warnings = append(d.warnings, err)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package tnef
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/teamwork/test"
+)
+
+func TestFilename(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"attachments", []string{"zappa_av1.jpg", "bookmark.htm"}},
+		{"long-filename", []string{"allproductsmar2000.dat"}},
+		{"missing-filenames", []string{
+			"generpts.src",
+			"TechlibDEC99.doc",
+			"TechlibDEC99-JAN00.doc",
+			"TechlibNOV99.doc",
+		}},
+		{"multi-value-attribute", []string{"208225__5_seconds__Voice_Mail.mp3"}},
+		{"MAPI_OBJECT", []string{"Untitled_Attachment"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			out, err := Decode(test.Read(t, "./testdata", tt.in+".tnef"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, a := range out.Attachments {
+				got = append(got, a.Filename())
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("\ngot:  %q\nwant: %q", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("\ngot:  %q\nwant: %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestFilenameFallback(t *testing.T) {
+	tests := []struct {
+		in   Attachment
+		want string
+	}{
+		{Attachment{Title: "TITLE.TXT", transportFilename: "transport.txt"}, "TITLE.TXT"},
+		{Attachment{transportFilename: "transport.txt"}, "transport.txt"},
+		{Attachment{LongFilename: `C:\Users\x\..\evil.exe`}, "evil.exe"},
+		{Attachment{LongFilename: "../../etc/passwd"}, "passwd"},
+		{Attachment{LongFilename: "a\x00b\nc.txt"}, "abc.txt"},
+		{Attachment{LongFilename: ".."}, "attachment.dat"},
+		{Attachment{MimeType: "image/png"}, "attachment.png"},
+		{Attachment{MimeType: "text/plain; charset=utf-8"}, "attachment.txt"},
+		{Attachment{MimeType: "nonsense"}, "attachment.dat"},
+		{Attachment{}, "attachment.dat"},
+		{Attachment{Attributes: []MAPIAttribute{
+			{Type: szmapiString, Name: MAPIAttachFilename, Data: []byte("FILE.DOC\x00")},
+		}}, "FILE.DOC"},
+		{Attachment{MimeType: "image/png", Attributes: []MAPIAttribute{
+			{Type: szmapiString, Name: MAPIAttachExtension, Data: []byte(".jpeg\x00")},
+		}}, "attachment.jpeg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.in.Filename(); got != tt.want {
+				t.Errorf("\ngot:  %q\nwant: %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAttachDataObj(t *testing.T) {
+	tests := []struct {
+		in         string
+		wantMethod int
+		wantNames  []string
+		wantSizes  []int
+	}{
+		// Attached by value (AttachByValue), but the data is in
+		// MAPIAttachDataObj rather than ATTATTACHDATA; there's no embedded
+		// message.
+		{"MAPI_ATTACH_DATA_OBJ", AttachByValue,
+			[]string{"VIA_Nytt_1402.doc", "VIA_Nytt_1402.pdf", "VIA_Nytt_14021.htm"},
+			[]int{61952, 213685, 68919}},
+		// OLE object (AttachOLE); the data is the OLE storage, not a TNEF
+		// stream, so it's not decoded as an embedded message.
+		{"MAPI_OBJECT", AttachOLE, []string{"Untitled_Attachment"}, []int{628224}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			out, err := Decode(test.Read(t, "./testdata", tt.in+".tnef"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(out.Attachments) != len(tt.wantNames) {
+				t.Fatalf("wrong number of attachments: %d", len(out.Attachments))
+			}
+			for i, a := range out.Attachments {
+				if a.Filename() != tt.wantNames[i] {
+					t.Errorf("wrong name: %q", a.Filename())
+				}
+				if len(a.Data) != tt.wantSizes[i] {
+					t.Errorf("wrong data size for %q: %d", a.Filename(), len(a.Data))
+				}
+				if a.Method != tt.wantMethod {
+					t.Errorf("wrong Method for %q: %d", a.Filename(), a.Method)
+				}
+				if a.Embedded != nil {
+					t.Errorf("Embedded for %q is not nil", a.Filename())
+				}
+			}
+		})
+	}
+}
+
+func TestEmbedded(t *testing.T) {
+	// Message with a nested message, which has another nested message.
+	inner := &Data{Body: []byte("inner\x00")}
+	middle := &Data{
+		Body:        []byte("middle\x00"),
+		Attachments: []*Attachment{{Title: "inner.msg", Embedded: inner}},
+	}
+	outer := &Data{
+		Body:        []byte("outer\x00"),
+		Attachments: []*Attachment{{Title: "middle.msg", Embedded: middle}},
+	}
+	buf := new(bytes.Buffer)
+	if err := Encode(buf, outer); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("default", func(t *testing.T) {
+		out, err := Decode(buf.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		a := out.Attachments[0]
+		if a.Method != AttachEmbeddedMsg {
+			t.Errorf("wrong Method: %d", a.Method)
+		}
+		if a.Embedded == nil {
+			t.Fatal("Embedded is nil")
+		}
+		if string(a.Embedded.Body) != "middle\x00" {
+			t.Errorf("wrong body: %q", a.Embedded.Body)
+		}
+		e := a.Embedded.Attachments[0].Embedded
+		if e == nil {
+			t.Fatal("nested Embedded is nil")
+		}
+		if string(e.Body) != "inner\x00" {
+			t.Errorf("wrong nested body: %q", e.Body)
+		}
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		out, err := DecodeWithOptions(buf.Bytes(), DecodeOptions{MaxDepth: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		middle := out.Attachments[0].Embedded
+		if middle == nil {
+			t.Fatal("Embedded is nil")
+		}
+		if middle.Attachments[0].Embedded != nil {
+			t.Error("nested message was decoded")
+		}
+		if len(middle.Warnings) != 1 || middle.Warnings[0] != ErrMaxDepth {
+			t.Errorf("wrong warnings: %v", middle.Warnings)
+		}
+		if len(out.Warnings) != 1 || out.Warnings[0] != ErrMaxDepth {
+			t.Errorf("warnings not propagated: %v", out.Warnings)
+		}
+
+		_, err = DecodeWithOptions(buf.Bytes(), DecodeOptions{MaxDepth: 1, Strict: true})
+		if err != ErrMaxDepth {
+			t.Errorf("wrong error: %v", err)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		out, err := DecodeWithOptions(buf.Bytes(), DecodeOptions{MaxDepth: -1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out.Attachments[0].Embedded != nil {
+			t.Error("Embedded is not nil")
+		}
+	})
+}
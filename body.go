@@ -0,0 +1,79 @@
+package tnef
+
+import "strings"
+
+// TextBody gets the plain text body as UTF-8.
+//
+// The body is taken from PR_BODY, which is either Unicode or 8-bit text in the
+// code page of the message (see Data.Codepage), or the legacy ATTBODY or the
+// text encapsulated in the RTF body if it's not present. Trailing NUL bytes and
+// a byte order mark are removed, and invalid UTF-8 is replaced with U+FFFD.
+//
+// An error is returned if PR_BODY isn't a string property.
+func (t *Data) TextBody() (string, error) {
+	if t.textFromRTF {
+		return normalizeText(string(t.Body)), nil
+	}
+	if attr, ok := findAttr(t.Attributes, MAPIBody); ok {
+		if attr.Type == szmapiString && !attr.IsMultiValue {
+			return normalizeText(legacyString(t.Codepage, attr.Data)), nil
+		}
+		s, err := attr.StringValue()
+		if err != nil {
+			return "", err
+		}
+		return normalizeText(s), nil
+	}
+	return normalizeText(legacyString(t.Codepage, t.Body)), nil
+}
+
+// HTMLBody gets the HTML body as UTF-8.
+//
+// PR_HTML is binary data in the code page given by PR_INTERNET_CPID, or in the
+// code page of the message if that's not present; it may also be stored as a
+// (Unicode) string. HTML encapsulated in the RTF body is already UTF-8.
+// Trailing NUL bytes and a byte order mark are removed, and invalid UTF-8 is
+// replaced with U+FFFD. Note that a charset in a <meta> tag in the HTML isn't
+// updated.
+//
+// An error is returned if PR_HTML has an unexpected type.
+func (t *Data) HTMLBody() (string, error) {
+	if t.htmlFromRTF {
+		return normalizeText(string(t.BodyHTML)), nil
+	}
+
+	data := t.BodyHTML
+	if attr, ok := findAttr(t.Attributes, MAPIHTML); ok {
+		switch {
+		case attr.IsMultiValue:
+			return "", attr.typeError("HTML")
+		case attr.Type == szmapiUnicodeString:
+			s, err := attr.StringValue()
+			return normalizeText(s), err
+		case attr.Type == szmapiString || attr.Type == szmapiBinary:
+			data = attr.Data
+		default:
+			return "", attr.typeError("HTML")
+		}
+	}
+	return normalizeText(legacyString(t.htmlCodepage(), data)), nil
+}
+
+// htmlCodepage gets the code page of the HTML body: PR_INTERNET_CPID, or the
+// code page of the message.
+func (t *Data) htmlCodepage() int {
+	if attr, ok := findAttr(t.Attributes, MAPIInternetCPID); ok {
+		if cp, err := attr.Int(); err == nil && charsetName(cp) != "" {
+			return cp
+		}
+	}
+	return t.Codepage
+}
+
+// normalizeText removes trailing NUL bytes and a leading byte order mark from
+// s, and replaces invalid UTF-8.
+func normalizeText(s string) string {
+	s = strings.TrimRight(s, "\x00")
+	s = strings.TrimPrefix(s, "\uFEFF")
+	return strings.ToValidUTF8(s, "\uFFFD")
+}
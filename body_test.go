@@ -0,0 +1,94 @@
+package tnef
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/teamwork/test"
+)
+
+func TestTextBody(t *testing.T) {
+	tests := []struct {
+		in      Data
+		want    string
+		wantErr error
+	}{
+		{Data{Attributes: []MAPIAttribute{
+			{Type: szmapiUnicodeString, Name: MAPIBody, Data: encodeUnicode("Привет\r\n")},
+		}}, "Привет\r\n", nil},
+		{Data{Codepage: 1251, Attributes: []MAPIAttribute{
+			{Type: szmapiString, Name: MAPIBody, Data: []byte("\xcf\xf0\xe8\xe2\xe5\xf2\x00\x00")},
+		}}, "Привет", nil},
+		{Data{Codepage: 1252, Body: []byte("caf\xe9\x00")}, "café", nil},
+		{Data{Body: []byte("\xef\xbb\xbfcafé\x00")}, "café", nil},
+		{Data{Attributes: []MAPIAttribute{
+			{Type: szmapiInt, Name: MAPIBody, Data: le32(1)},
+		}}, "", ErrPropertyType},
+	}
+	for i, tt := range tests {
+		got, err := tt.in.TextBody()
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%d: wrong error: %v", i, err)
+		}
+		if got != tt.want {
+			t.Errorf("%d: got %q, want %q", i, got, tt.want)
+		}
+	}
+}
+
+func TestHTMLBody(t *testing.T) {
+	tests := []struct {
+		in      Data
+		want    string
+		wantErr error
+	}{
+		// PR_INTERNET_CPID overrides the code page of the message.
+		{Data{Codepage: 1252, BodyHTML: []byte("<p>\x93\xfa\x96\x7b</p>\x00"), Attributes: []MAPIAttribute{
+			{Type: szmapiBinary, Name: MAPIHTML, Data: []byte("<p>\x93\xfa\x96\x7b</p>\x00")},
+			{Type: szmapiInt, Name: MAPIInternetCPID, Data: le32(932)},
+		}}, "<p>日本</p>", nil},
+		{Data{Codepage: 1250, BodyHTML: []byte("<p>\x8aer\xfd</p>")}, "<p>Šerý</p>", nil},
+		{Data{Attributes: []MAPIAttribute{
+			{Type: szmapiUnicodeString, Name: MAPIHTML, Data: encodeUnicode("<p>日本</p>")},
+		}}, "<p>日本</p>", nil},
+		{Data{Attributes: []MAPIAttribute{
+			{Type: szmapiInt, Name: MAPIHTML, Data: le32(1)},
+		}}, "", ErrPropertyType},
+	}
+	for i, tt := range tests {
+		got, err := tt.in.HTMLBody()
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%d: wrong error: %v", i, err)
+		}
+		if got != tt.want {
+			t.Errorf("%d: got %q, want %q", i, got, tt.want)
+		}
+	}
+}
+
+func TestBodyFromRTF(t *testing.T) {
+	d, err := Decode(test.Read(t, "./testdata", "multi-value-attribute.tnef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	html, err := d.HTMLBody()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(html, "<html><head>") || strings.HasSuffix(html, "\x00") {
+		t.Errorf("wrong HTMLBody: %q", html)
+	}
+
+	d, err = Decode(test.Read(t, "./testdata", "long-filename.tnef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	text, err := d.TextBody()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(text, "I've attached a temp. license") {
+		t.Errorf("wrong TextBody: %q", text)
+	}
+}
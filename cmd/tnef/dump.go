@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/teamwork/tnef"
+)
+
+func dump(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("dump", flag.ContinueOnError)
+	path, err := parseFlags(fs, args, stderr)
+	if err != nil {
+		return err
+	}
+	f, err := openFile(path, stdin)
+	if err != nil {
+		return err
+	}
+	defer f.Close() // nolint: errcheck
+
+	return dumpStream(stdout, stderr, tnef.NewDecoder(f), "")
+}
+
+// dumpStream shows the objects as they're read from dec, followed by the
+// decoded MAPI properties of the message and the attachments. Embedded
+// messages are shown the same way, indented.
+func dumpStream(w, stderr io.Writer, dec *tnef.Decoder, indent string) error {
+	var (
+		props       []tnef.MAPIAttribute
+		attachments []*tnef.Attachment
+	)
+	fmt.Fprintf(w, "%sObjects:\n", indent)
+	for {
+		o, err := dec.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		level := "message"
+		if o.Level == 0x02 {
+			level = "attachment"
+		}
+		fmt.Fprintf(w, "%s  %08x  %-10s  %-28s  %-6s  %d bytes\n",
+			indent, o.Offset, level, attrName(o.Name, o.Type), attrTypes[o.Type], o.Length)
+
+		switch {
+		case o.Name == tnef.ATTMAPIPROPS:
+			props, err = o.Attributes()
+		case o.Level == 0x02:
+			if o.Name == tnef.ATTATTACHRENDDATA || len(attachments) == 0 {
+				attachments = append(attachments, &tnef.Attachment{})
+			}
+			err = addObject(attachments[len(attachments)-1], o)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, warn := range dec.Warnings() {
+		fmt.Fprintf(stderr, "tnef: warning: %v\n", warn)
+	}
+
+	fmt.Fprintf(w, "\n%sMessage properties:\n", indent)
+	dumpProps(w, props, indent+"  ")
+	for i, a := range attachments {
+		fmt.Fprintf(w, "\n%sAttachment %d (%s):\n", indent, i+1, a.Filename())
+		dumpProps(w, a.Attributes, indent+"  ")
+		if data := embeddedData(a); data != nil {
+			fmt.Fprintln(w)
+			sub := tnef.NewDecoder(bytes.NewReader(data))
+			if err := dumpStream(w, stderr, sub, indent+"  "); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func dumpProps(w io.Writer, attrs []tnef.MAPIAttribute, indent string) {
+	for _, attr := range attrs {
+		id := fmt.Sprintf("0x%04X", attr.Name)
+		name := attr.PropName()
+		if attr.Name >= 0x8000 {
+			if attr.NamedString != "" {
+				id += fmt.Sprintf(" %s %q", attr.PropSet, attr.NamedString)
+			} else {
+				id += fmt.Sprintf(" %s 0x%04X", attr.PropSet, attr.NamedID)
+			}
+			if _, ok := tnef.NamedPropByName(name); !ok {
+				name = id
+			}
+		}
+		if name != id {
+			id += " " + name
+		}
+		fmt.Fprintf(w, "%s%s  %s  %s\n", indent, id, propType(attr), propValue(attr))
+	}
+}
+
+// Names of the property types.
+var propTypes = map[int]string{
+	0x0002: "PT_SHORT",
+	0x0003: "PT_LONG",
+	0x0004: "PT_FLOAT",
+	0x0005: "PT_DOUBLE",
+	0x0006: "PT_CURRENCY",
+	0x0007: "PT_APPTIME",
+	0x000A: "PT_ERROR",
+	0x000B: "PT_BOOLEAN",
+	0x000D: "PT_OBJECT",
+	0x0014: "PT_I8",
+	0x001E: "PT_STRING8",
+	0x001F: "PT_UNICODE",
+	0x0040: "PT_SYSTIME",
+	0x0048: "PT_CLSID",
+	0x0102: "PT_BINARY",
+}
+
+func propType(attr tnef.MAPIAttribute) string {
+	name, ok := propTypes[attr.Type]
+	if !ok {
+		name = fmt.Sprintf("0x%04X", attr.Type)
+	}
+	if attr.IsMultiValue {
+		name = strings.Replace(name, "PT_", "PT_MV_", 1)
+	}
+	return name
+}
+
+// Binary values are truncated to this many bytes.
+const maxBinary = 32
+
+// propValue formats the value of a property; every accessor is tried, as
+// they fail for other types.
+func propValue(attr tnef.MAPIAttribute) string {
+	if s, err := attr.StringValue(); err == nil {
+		return fmt.Sprintf("%q", s)
+	}
+	if s, err := attr.Strings(); err == nil {
+		return fmt.Sprintf("%q", s)
+	}
+	if t, err := attr.Time(); err == nil {
+		return t.Format(time.RFC3339)
+	}
+	if b, err := attr.Bool(); err == nil {
+		return fmt.Sprint(b)
+	}
+	if i, err := attr.Int64(); err == nil {
+		if i < 0 {
+			return fmt.Sprint(i)
+		}
+		return fmt.Sprintf("%d (0x%X)", i, i)
+	}
+	if i, err := attr.Int32s(); err == nil {
+		return fmt.Sprint(i)
+	}
+	if f, err := attr.Float(); err == nil {
+		return fmt.Sprint(f)
+	}
+	if g, err := attr.CLSID(); err == nil {
+		return g.String()
+	}
+
+	b := attr.Data
+	s := hex.EncodeToString(b)
+	if len(b) > maxBinary {
+		s = hex.EncodeToString(b[:maxBinary]) + "..."
+	}
+	return fmt.Sprintf("%s (%d bytes)", s, len(b))
+}
+
+// Names of the attribute types.
+var attrTypes = map[int]string{
+	0x0000: "triple",
+	0x0001: "string",
+	0x0002: "text",
+	0x0003: "date",
+	0x0004: "short",
+	0x0005: "long",
+	0x0006: "byte",
+	0x0007: "word",
+	0x0008: "dword",
+}
+
+// Names of the attributes.
+var attrNames = map[int]string{
+	tnef.ATTOWNER:                   "ATTOWNER",
+	tnef.ATTSENTFOR:                 "ATTSENTFOR",
+	tnef.ATTDELEGATE:                "ATTDELEGATE",
+	tnef.ATTDATESTART:               "ATTDATESTART",
+	tnef.ATTDATEEND:                 "ATTDATEEND",
+	tnef.ATTAIDOWNER:                "ATTAIDOWNER",
+	tnef.ATTREQUESTRES:              "ATTREQUESTRES",
+	tnef.ATTFROM:                    "ATTFROM",
+	tnef.ATTSUBJECT:                 "ATTSUBJECT",
+	tnef.ATTDATESENT:                "ATTDATESENT",
+	tnef.ATTDATERECD:                "ATTDATERECD",
+	tnef.ATTMESSAGESTATUS:           "ATTMESSAGESTATUS",
+	tnef.ATTMESSAGECLASS:            "ATTMESSAGECLASS",
+	tnef.ATTMESSAGEID:               "ATTMESSAGEID",
+	tnef.ATTPARENTID:                "ATTPARENTID",
+	tnef.ATTCONVERSATIONID:          "ATTCONVERSATIONID",
+	tnef.ATTBODY:                    "ATTBODY",
+	tnef.ATTPRIORITY:                "ATTPRIORITY",
+	tnef.ATTATTACHDATA:              "ATTATTACHDATA",
+	tnef.ATTATTACHTITLE:             "ATTATTACHTITLE",
+	tnef.ATTATTACHMETAFILE:          "ATTATTACHMETAFILE",
+	tnef.ATTATTACHCREATEDATE:        "ATTATTACHCREATEDATE",
+	tnef.ATTATTACHMODIFYDATE:        "ATTATTACHMODIFYDATE",
+	tnef.ATTDATEMODIFY:              "ATTDATEMODIFY",
+	tnef.ATTATTACHTRANSPORTFILENAME: "ATTATTACHTRANSPORTFILENAME",
+	tnef.ATTATTACHRENDDATA:          "ATTATTACHRENDDATA",
+	tnef.ATTMAPIPROPS:               "ATTMAPIPROPS",
+	tnef.ATTRECIPTABLE:              "ATTRECIPTABLE",
+	tnef.ATTATTACHMENT:              "ATTATTACHMENT",
+	tnef.ATTTNEFVERSION:             "ATTTNEFVERSION",
+	tnef.ATTOEMCODEPAGE:             "ATTOEMCODEPAGE",
+	tnef.ATTORIGNINALMESSAGECLASS:   "ATTORIGINALMESSAGECLASS",
+}
+
+func attrName(name, typ int) string {
+	// The original message class has the same name as ATTDATESTART.
+	if name == tnef.ATTDATESTART && typ == 0x0007 {
+		return "ATTORIGINALMESSAGECLASS"
+	}
+	if n, ok := attrNames[name]; ok {
+		return n
+	}
+	return fmt.Sprintf("0x%04X", name)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Overwrite policies for extract.
+const (
+	overwriteRename  = "rename"  // Add a number to the name.
+	overwriteSkip    = "skip"    // Don't write the file.
+	overwriteReplace = "replace" // Overwrite the file.
+)
+
+func extract(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("extract", flag.ContinueOnError)
+	dir := fs.String("d", ".", "write the files to `dir`, which is created if needed")
+	overwrite := fs.String("overwrite", overwriteRename, "what to do if a file exists: rename, skip, or replace")
+	path, err := parseFlags(fs, args, stderr)
+	if err != nil {
+		return err
+	}
+	switch *overwrite {
+	case overwriteRename, overwriteSkip, overwriteReplace:
+	default:
+		fmt.Fprintf(stderr, "tnef extract: unknown overwrite policy %q\n", *overwrite)
+		return errUsage
+	}
+
+	d, err := decodeFile(path, stdin)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(*dir, 0755); err != nil {
+		return err
+	}
+
+	x := &extractor{dir: *dir, overwrite: *overwrite, stdout: stdout}
+	if text, _ := d.TextBody(); text != "" {
+		x.write("body.txt", []byte(text))
+	}
+	if html, _ := d.HTMLBody(); html != "" {
+		x.write("body.html", []byte(html))
+	}
+	if len(d.BodyRTF) > 0 {
+		x.write("body.rtf", d.BodyRTF)
+	}
+
+	for _, a := range d.Attachments {
+		name := a.Filename()
+		if a.Embedded != nil {
+			// Write embedded messages as emails.
+			buf := new(bytes.Buffer)
+			if err := a.Embedded.WriteMIME(buf); err != nil {
+				return err
+			}
+			x.write(strings.TrimSuffix(name, filepath.Ext(name))+".eml", buf.Bytes())
+			continue
+		}
+		x.write(name, a.Data)
+	}
+	return x.err
+}
+
+type extractor struct {
+	dir       string
+	overwrite string
+	stdout    io.Writer
+	err       error
+}
+
+// write writes the file in the directory, following the overwrite policy, and
+// prints its path. name must be safe to use as a filename (e.g. from
+// Attachment.Filename).
+func (x *extractor) write(name string, data []byte) {
+	if x.err != nil {
+		return
+	}
+
+	path := filepath.Join(x.dir, name)
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if x.overwrite == overwriteReplace {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+
+	fp, err := os.OpenFile(path, flags, 0644)
+	for i := 1; os.IsExist(err); i++ {
+		if x.overwrite == overwriteSkip {
+			fmt.Fprintf(x.stdout, "skipped %s\n", path)
+			return
+		}
+		ext := filepath.Ext(name)
+		path = filepath.Join(x.dir, fmt.Sprintf("%s (%d)%s", strings.TrimSuffix(name, ext), i, ext))
+		fp, err = os.OpenFile(path, flags, 0644)
+	}
+	if err != nil {
+		x.err = err
+		return
+	}
+
+	if _, err := fp.Write(data); err != nil {
+		_ = fp.Close()
+		x.err = err
+		return
+	}
+	if x.err = fp.Close(); x.err == nil {
+		fmt.Fprintln(x.stdout, path)
+	}
+}
@@ -0,0 +1,317 @@
+// Command tnef inspects and extracts Microsoft TNEF files (winmail.dat).
+//
+// Usage:
+//
+//	tnef list file
+//	tnef extract [-d dir] [-overwrite rename|skip|replace] file
+//	tnef dump file
+//	tnef convert [-f eml|ics|vcf] [-o output] file
+//
+// The file can be "-" to read from stdin.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/teamwork/tnef"
+)
+
+const usage = `usage: tnef <command> [flags] file
+
+Commands:
+  list      list the attachments, with their sizes and MIME types
+  extract   write the attachments and bodies to a directory
+  dump      show every TNEF object and MAPI property
+  convert   convert to an email (.eml), iCalendar (.ics), or vCard (.vcf)
+
+Run "tnef <command> -h" for the flags of a command. The file can be "-" to
+read from stdin.
+`
+
+// errUsage signals that the command line is invalid; the usage has already
+// been printed.
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	switch {
+	case err == errUsage:
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "tnef: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+var commands = map[string]func(args []string, stdin io.Reader, stdout, stderr io.Writer) error{
+	"list":    list,
+	"extract": extract,
+	"dump":    dump,
+	"convert": convert,
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return errUsage
+	}
+	if args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		fmt.Fprint(stdout, usage)
+		return nil
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "tnef: unknown command %q\n\n%s", args[0], usage)
+		return errUsage
+	}
+	return cmd(args[1:], stdin, stdout, stderr)
+}
+
+// parseFlags parses the flags of a command, which takes a single file as
+// argument.
+func parseFlags(fs *flag.FlagSet, args []string, stderr io.Writer) (string, error) {
+	fs.SetOutput(stderr)
+	if err := fs.Parse(args); err != nil {
+		return "", errUsage
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprintf(stderr, "tnef %s: expected one file\n", fs.Name())
+		fs.Usage()
+		return "", errUsage
+	}
+	return fs.Arg(0), nil
+}
+
+// openFile opens the file, or stdin if it's "-".
+func openFile(path string, stdin io.Reader) (io.ReadCloser, error) {
+	if path == "-" {
+		return ioutil.NopCloser(stdin), nil
+	}
+	return os.Open(path)
+}
+
+func decodeFile(path string, stdin io.Reader) (*tnef.Data, error) {
+	f, err := openFile(path, stdin)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() // nolint: errcheck
+
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	return tnef.Decode(data)
+}
+
+// addObject adds the attachment object o to a; the attachment data isn't
+// read.
+func addObject(a *tnef.Attachment, o *tnef.Object) error {
+	switch o.Name {
+	case tnef.ATTATTACHTITLE:
+		// Titles are almost always ASCII; Filename prefers the (Unicode) long
+		// filename anyway.
+		b, err := o.Bytes()
+		if err != nil {
+			return err
+		}
+		a.Title = strings.TrimRight(string(b), "\x00")
+	case tnef.ATTATTACHMENT:
+		var err error
+		if a.Attributes, err = o.Attributes(); err != nil {
+			return err
+		}
+		for _, attr := range a.Attributes {
+			switch attr.Name {
+			case tnef.MAPIAttachLongFilename:
+				a.LongFilename, _ = attr.StringValue()
+			case tnef.MAPIAttachMimeTag:
+				a.MimeType, _ = attr.StringValue()
+			}
+		}
+	}
+	return nil
+}
+
+// signature is the start of a TNEF stream.
+var signature = []byte{0x78, 0x9f, 0x3e, 0x22}
+
+// embeddedData gets the TNEF stream of an embedded message in a, or nil if a
+// isn't an embedded message.
+func embeddedData(a *tnef.Attachment) []byte {
+	for _, attr := range a.Attributes {
+		// An object; the stream is preceded by the interface identifier.
+		if attr.Name == tnef.MAPIAttachDataObj && attr.Type == 0x000D && len(attr.Data) > 16 &&
+			bytes.HasPrefix(attr.Data[16:], signature) {
+			return attr.Data[16:]
+		}
+	}
+	return nil
+}
+
+// isFormatError reports whether err is a problem with the data, rather than
+// with reading it.
+func isFormatError(err error) bool {
+	var fmtErr *tnef.FormatError
+	return errors.As(err, &fmtErr)
+}
+
+func list(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	path, err := parseFlags(fs, args, stderr)
+	if err != nil {
+		return err
+	}
+	f, err := openFile(path, stdin)
+	if err != nil {
+		return err
+	}
+	defer f.Close() // nolint: errcheck
+
+	// Read the attachments without their data, which can be large.
+	var (
+		attachments []*tnef.Attachment
+		sizes       []int
+		warnings    []error
+	)
+	dec := tnef.NewDecoder(f)
+	for {
+		o, err := dec.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// Show what was read so far, like tnef.Decode.
+			if isFormatError(err) {
+				warnings = append(warnings, err)
+				break
+			}
+			return err
+		}
+		if o.Level != 0x02 {
+			continue
+		}
+		if o.Name == tnef.ATTATTACHRENDDATA || len(attachments) == 0 {
+			attachments = append(attachments, &tnef.Attachment{})
+			sizes = append(sizes, 0)
+		}
+		if o.Name == tnef.ATTATTACHDATA {
+			sizes[len(sizes)-1] = o.Length
+		}
+		if err := addObject(attachments[len(attachments)-1], o); err != nil {
+			return err
+		}
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "SIZE\tTYPE\tNAME")
+	for i, a := range attachments {
+		size, mimeType := sizes[i], a.MimeType
+		if data := embeddedData(a); data != nil {
+			// The size of the TNEF stream of the embedded message.
+			size, mimeType = len(data), "message/rfc822"
+		} else if size == 0 {
+			// The data is only in the MAPI properties.
+			for _, attr := range a.Attributes {
+				if attr.Name == tnef.MAPIAttachDataObj {
+					size = len(attr.Data)
+					if attr.Type == 0x000D && size >= 16 {
+						size -= 16 // Interface identifier.
+					}
+				}
+			}
+		}
+		if mimeType == "" {
+			mimeType = "-"
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\n", size, mimeType, a.Filename())
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, w := range append(dec.Warnings(), warnings...) {
+		fmt.Fprintf(stderr, "tnef: warning: %v\n", w)
+	}
+	return nil
+}
+
+func convert(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("convert", flag.ContinueOnError)
+	format := fs.String("f", "", "output `format`: eml, ics, or vcf; the default depends on the message class")
+	output := fs.String("o", "", "write to `file` instead of stdout")
+	path, err := parseFlags(fs, args, stderr)
+	if err != nil {
+		return err
+	}
+	d, err := decodeFile(path, stdin)
+	if err != nil {
+		return err
+	}
+
+	f := strings.ToLower(*format)
+	switch f {
+	case "":
+		f = defaultFormat(d)
+	case "eml", "ics", "vcf":
+	default:
+		fmt.Fprintf(stderr, "tnef convert: unknown format %q\n", *format)
+		return errUsage
+	}
+
+	// Convert in to a buffer first, so that an existing output file isn't
+	// truncated if the conversion fails.
+	buf := new(bytes.Buffer)
+	var warnings []error
+	switch f {
+	case "eml":
+		err = d.WriteMIME(buf)
+	case "ics":
+		if a := d.Appointment(); a != nil {
+			err = a.WriteICalendar(buf)
+			warnings = a.Warnings
+		} else if t := d.Task(); t != nil {
+			err = t.WriteICalendar(buf)
+			warnings = t.Warnings
+		} else {
+			err = fmt.Errorf("%s is not a calendar item or task", d.MessageClass)
+		}
+	case "vcf":
+		if c := d.Contact(); c != nil {
+			err = c.WriteVCard(buf)
+		} else {
+			err = fmt.Errorf("%s is not a contact", d.MessageClass)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	for _, w := range warnings {
+		fmt.Fprintf(stderr, "tnef: warning: %v\n", w)
+	}
+
+	if *output != "" {
+		return ioutil.WriteFile(*output, buf.Bytes(), 0644)
+	}
+	_, err = stdout.Write(buf.Bytes())
+	return err
+}
+
+// defaultFormat gets the format to convert the message to.
+func defaultFormat(d *tnef.Data) string {
+	switch {
+	case d.Appointment() != nil, d.Task() != nil:
+		return "ics"
+	case d.Contact() != nil:
+		return "vcf"
+	}
+	return "eml"
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/teamwork/tnef"
+)
+
+func runCmd(t *testing.T, args ...string) string {
+	t.Helper()
+	stdout, stderr := new(bytes.Buffer), new(bytes.Buffer)
+	if err := run(args, nil, stdout, stderr); err != nil {
+		t.Fatalf("%v: %v\n%s", args, err, stderr)
+	}
+	return stdout.String()
+}
+
+func TestList(t *testing.T) {
+	got := runCmd(t, "list", "../../testdata/unicode-mapi-attr-name.tnef")
+	want := `SIZE  TYPE       NAME
+8387  -          spaconsole2.cfg
+3815  image/png  image001.png
+3573  image/png  image002.png
+3792  image/png  image003.png
+`
+	if got != want {
+		t.Errorf("\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestListEmbedded(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tnef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	inner := new(bytes.Buffer)
+	if err := tnef.Encode(inner, &tnef.Data{Subject: "Forwarded"}); err != nil {
+		t.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	err = tnef.Encode(buf, &tnef.Data{Attachments: []*tnef.Attachment{{
+		Title:    "Forwarded",
+		Embedded: &tnef.Data{Subject: "Forwarded"},
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "winmail.dat")
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got := runCmd(t, "list", path)
+	want := fmt.Sprintf("SIZE  TYPE            NAME\n%-4d  message/rfc822  Forwarded\n", inner.Len())
+	if got != want {
+		t.Errorf("\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	// The embedded message is shown indented.
+	got = runCmd(t, "dump", path)
+	for _, want := range []string{"\n  Objects:\n", "ATTSUBJECT", "\n  Message properties:\n"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("%q not in output:\n%s", want, got)
+		}
+	}
+}
+
+func TestExtract(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tnef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir) // nolint: errcheck
+
+	files := func() []string {
+		infos, err := ioutil.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, fi := range infos {
+			names = append(names, fi.Name())
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	runCmd(t, "extract", "-d", dir, "../../testdata/two-files.tnef")
+	if want := []string{"AUTHORS", "README"}; !reflect.DeepEqual(files(), want) {
+		t.Errorf("wrong files: %v", files())
+	}
+
+	runCmd(t, "extract", "-d", dir, "-overwrite", "skip", "../../testdata/two-files.tnef")
+	if want := []string{"AUTHORS", "README"}; !reflect.DeepEqual(files(), want) {
+		t.Errorf("wrong files after skip: %v", files())
+	}
+
+	runCmd(t, "extract", "-d", dir, "../../testdata/two-files.tnef")
+	if want := []string{"AUTHORS", "AUTHORS (1)", "README", "README (1)"}; !reflect.DeepEqual(files(), want) {
+		t.Errorf("wrong files after rename: %v", files())
+	}
+
+	want, err := ioutil.ReadFile(filepath.Join(dir, "README"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(filepath.Join(dir, "README (1)"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("files are different")
+	}
+}
+
+func TestDump(t *testing.T) {
+	got := runCmd(t, "dump", "../../testdata/triples.tnef")
+	for _, want := range []string{
+		"ATTMESSAGECLASS",
+		"ATTMAPIPROPS",
+		"Message properties:",
+		`0x0070 PidTagConversationTopic  PT_STRING8  "Sample Summary"`,
+		"0x8047 {00062002-0000-0000-C000-000000000046} 0x820D PidLidAppointmentStartWhole  PT_SYSTIME  2003-05-23T14:00:00Z",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("%q not in output:\n%s", want, got)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	// Calendar items are converted to iCalendar by default.
+	got := runCmd(t, "convert", "../../testdata/triples.tnef")
+	if !strings.HasPrefix(got, "BEGIN:VCALENDAR\r\n") {
+		t.Errorf("not iCalendar:\n%s", got)
+	}
+
+	got = runCmd(t, "convert", "-f", "eml", "../../testdata/triples.tnef")
+	if !strings.Contains(got, "Subject: Sample Summary\r\n") {
+		t.Errorf("not an email:\n%s", got)
+	}
+
+	err := run([]string{"convert", "-f", "vcf", "../../testdata/triples.tnef"}, nil, ioutil.Discard, ioutil.Discard)
+	if err == nil {
+		t.Error("no error converting an appointment to vCard")
+	}
+
+	// The output file isn't touched if the conversion fails.
+	dir, err := ioutil.TempDir("", "tnef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir) // nolint: errcheck
+	out := filepath.Join(dir, "out")
+	if err := ioutil.WriteFile(out, []byte("keep"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err = run([]string{"convert", "-f", "vcf", "-o", out, "../../testdata/triples.tnef"}, nil, ioutil.Discard, ioutil.Discard)
+	if err == nil {
+		t.Error("no error converting an appointment to vCard")
+	}
+	if b, _ := ioutil.ReadFile(out); string(b) != "keep" {
+		t.Errorf("output file was changed: %q", b)
+	}
+
+	runCmd(t, "convert", "-o", out, "../../testdata/triples.tnef")
+	if b, _ := ioutil.ReadFile(out); !strings.HasPrefix(string(b), "BEGIN:VCALENDAR\r\n") {
+		t.Errorf("not iCalendar:\n%s", b)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	for _, args := range [][]string{nil, {"foo"}, {"list"}, {"extract", "-overwrite", "foo", "x.tnef"}} {
+		if err := run(args, nil, ioutil.Discard, ioutil.Discard); err != errUsage {
+			t.Errorf("%v: wrong error: %v", args, err)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package tnef
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// codepages maps Windows code page identifiers, as used in ATTOEMCODEPAGE,
+// PR_MESSAGE_CODEPAGE, PR_INTERNET_CPID, and \ansicpg in RTF, to the MIME
+// charset name and the encoding.
+var codepages = map[int]struct {
+	charset string
+	enc     encoding.Encoding
+}{
+	437:   {"ibm437", charmap.CodePage437},
+	850:   {"ibm850", charmap.CodePage850},
+	852:   {"ibm852", charmap.CodePage852},
+	855:   {"ibm855", charmap.CodePage855},
+	858:   {"ibm00858", charmap.CodePage858},
+	860:   {"ibm860", charmap.CodePage860},
+	862:   {"ibm862", charmap.CodePage862},
+	863:   {"ibm863", charmap.CodePage863},
+	865:   {"ibm865", charmap.CodePage865},
+	866:   {"ibm866", charmap.CodePage866},
+	874:   {"windows-874", charmap.Windows874},
+	932:   {"shift_jis", japanese.ShiftJIS},
+	936:   {"gbk", simplifiedchinese.GBK},
+	949:   {"euc-kr", korean.EUCKR},
+	950:   {"big5", traditionalchinese.Big5},
+	1200:  {"utf-16le", unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)},
+	1201:  {"utf-16be", unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)},
+	1250:  {"windows-1250", charmap.Windows1250},
+	1251:  {"windows-1251", charmap.Windows1251},
+	1252:  {"windows-1252", charmap.Windows1252},
+	1253:  {"windows-1253", charmap.Windows1253},
+	1254:  {"windows-1254", charmap.Windows1254},
+	1255:  {"windows-1255", charmap.Windows1255},
+	1256:  {"windows-1256", charmap.Windows1256},
+	1257:  {"windows-1257", charmap.Windows1257},
+	1258:  {"windows-1258", charmap.Windows1258},
+	10000: {"macintosh", charmap.Macintosh},
+	20866: {"koi8-r", charmap.KOI8R},
+	20932: {"euc-jp", japanese.EUCJP},
+	21866: {"koi8-u", charmap.KOI8U},
+	28591: {"iso-8859-1", charmap.ISO8859_1},
+	28592: {"iso-8859-2", charmap.ISO8859_2},
+	28593: {"iso-8859-3", charmap.ISO8859_3},
+	28594: {"iso-8859-4", charmap.ISO8859_4},
+	28595: {"iso-8859-5", charmap.ISO8859_5},
+	28596: {"iso-8859-6", charmap.ISO8859_6},
+	28597: {"iso-8859-7", charmap.ISO8859_7},
+	28598: {"iso-8859-8", charmap.ISO8859_8},
+	28599: {"iso-8859-9", charmap.ISO8859_9},
+	28600: {"iso-8859-10", charmap.ISO8859_10},
+	28603: {"iso-8859-13", charmap.ISO8859_13},
+	28604: {"iso-8859-14", charmap.ISO8859_14},
+	28605: {"iso-8859-15", charmap.ISO8859_15},
+	28606: {"iso-8859-16", charmap.ISO8859_16},
+	50220: {"iso-2022-jp", japanese.ISO2022JP},
+	50221: {"iso-2022-jp", japanese.ISO2022JP},
+	50222: {"iso-2022-jp", japanese.ISO2022JP},
+	51932: {"euc-jp", japanese.EUCJP},
+	51949: {"euc-kr", korean.EUCKR},
+	52936: {"hz-gb-2312", simplifiedchinese.HZGB2312},
+	54936: {"gb18030", simplifiedchinese.GB18030},
+	65001: {"utf-8", unicode.UTF8},
+}
+
+// charsetName gets the MIME charset name of the code page cp, or "" if it's
+// not supported.
+func charsetName(cp int) string {
+	return codepages[cp].charset
+}
+
+// decodeCodepage converts 8-bit text in the Windows code page cp to UTF-8.
+//
+// If the code page is unknown (or 0 if it wasn't given) text that is valid
+// UTF-8 is returned as-is, and anything else is treated as Windows-1252.
+func decodeCodepage(cp int, b []byte) string {
+	c, ok := codepages[cp]
+	if !ok {
+		if utf8.Valid(b) {
+			return string(b)
+		}
+		c = codepages[1252]
+	}
+
+	s, err := c.enc.NewDecoder().Bytes(b)
+	if err != nil {
+		// Single-byte code pages never fail.
+		s, _ = charmap.Windows1252.NewDecoder().Bytes(b)
+	}
+	return string(s)
+}
+
+// encodeCodepage converts s to the Windows code page cp, replacing characters
+// that can't be represented. Unknown code pages are treated as Windows-1252.
+func encodeCodepage(cp int, s string) []byte {
+	c, ok := codepages[cp]
+	if !ok {
+		c = codepages[1252]
+	}
+	b, err := encoding.ReplaceUnsupported(c.enc.NewEncoder()).Bytes([]byte(s))
+	if err != nil {
+		return []byte(s)
+	}
+	return b
+}
+
+// Charset gets the MIME charset name of the code page of the message, e.g.
+// "windows-1252" or "shift_jis", or "" if it's unknown.
+func (t *Data) Charset() string {
+	return charsetName(t.Codepage)
+}
+
+// mapiCodepage gets the code page from the MAPI properties of a message, for
+// messages without ATTOEMCODEPAGE; 0 if they don't have one either.
+func mapiCodepage(attrs []MAPIAttribute) int {
+	for _, name := range []int{MAPIMessageCodepage, MAPIInternetCPID} {
+		if attr, ok := findAttr(attrs, name); ok {
+			if cp, err := attr.Int(); err == nil && cp > 0 {
+				return cp
+			}
+		}
+	}
+	return 0
+}
+
+// setCodepage sets the code page used to decode 8-bit strings in attrs.
+func setCodepage(attrs []MAPIAttribute, cp int) {
+	for i := range attrs {
+		attrs[i].codepage = cp
+	}
+}
@@ -0,0 +1,75 @@
+package tnef
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecodeCodepage(t *testing.T) {
+	tests := []struct {
+		cp   int
+		in   []byte
+		want string
+	}{
+		{1252, []byte("caf\xe9 \x80"), "café €"},
+		{1251, []byte("\xcf\xf0\xe8\xe2\xe5\xf2"), "Привет"},
+		{1250, []byte("\x8aer\xfd"), "Šerý"},
+		{932, []byte("\x93\xfa\x96\x7b"), "日本"},
+		{50220, []byte("\x1b$BF|K\\\x1b(B"), "日本"},
+		{65001, []byte("café"), "café"},
+		{0, []byte("café"), "café"},        // Unknown, but valid UTF-8.
+		{0, []byte("caf\xe9"), "café"},     // Unknown, so Windows-1252.
+		{12345, []byte("caf\xe9"), "café"}, // Unsupported.
+	}
+	for _, tt := range tests {
+		if got := decodeCodepage(tt.cp, tt.in); got != tt.want {
+			t.Errorf("%d: got %q, want %q", tt.cp, got, tt.want)
+		}
+	}
+
+	if got := encodeCodepage(1251, "Привет €"); !bytes.Equal(got, []byte("\xcf\xf0\xe8\xe2\xe5\xf2 \x88")) {
+		t.Errorf("wrong encoding: %q", got)
+	}
+	if got := encodeCodepage(1252, "日本"); string(got) != "\x1a\x1a" {
+		t.Errorf("wrong encoding: %q", got)
+	}
+}
+
+func TestCodepage(t *testing.T) {
+	in := &Data{
+		Codepage: 932,
+		Attributes: []MAPIAttribute{
+			{Type: szmapiString, Name: MAPISubject, Data: []byte("\x93\xfa\x96\x7b\x00")},
+			{Type: szmapiInt, Name: MAPIInternetCPID, Data: le32(932)},
+		},
+		Attachments: []*Attachment{{Title: "報告.txt", Data: []byte("x")}},
+	}
+
+	buf := new(bytes.Buffer)
+	if err := Encode(buf, in); err != nil {
+		t.Fatal(err)
+	}
+	d, err := Decode(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Codepage != 932 || d.Charset() != "shift_jis" {
+		t.Errorf("wrong Codepage: %d %q", d.Codepage, d.Charset())
+	}
+	if d.Subject != "日本" {
+		t.Errorf("wrong Subject: %q", d.Subject)
+	}
+	if len(d.Attachments) != 1 || d.Attachments[0].Title != "報告.txt" {
+		t.Fatalf("wrong attachments: %#v", d.Attachments)
+	}
+
+	// Without ATTOEMCODEPAGE the code page from the MAPI properties is used.
+	d, err = Decode(bytes.Replace(buf.Bytes(), le16(ATTOEMCODEPAGE), le16(0x6666), 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Codepage != 932 || d.Subject != "日本" {
+		t.Errorf("wrong Codepage or Subject: %d %q", d.Codepage, d.Subject)
+	}
+}
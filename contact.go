@@ -0,0 +1,264 @@
+package tnef
+
+import (
+	"strings"
+	"time"
+)
+
+// Contact is an address book entry; see Data.Contact.
+type Contact struct {
+	// UID is the MessageID, which is kept when the contact is forwarded.
+	UID string
+
+	DisplayName string
+	Prefix      string // E.g. "Dr."
+	GivenName   string
+	MiddleName  string
+	Surname     string
+	Suffix      string // E.g. "Jr."
+	Nickname    string
+	Initials    string
+
+	Company        string
+	Department     string
+	Title          string // Job title.
+	OfficeLocation string
+	Profession     string
+	Manager        string
+	Assistant      string
+
+	// Emails are the Email1, Email2, and Email3 addresses, if set.
+	Emails    []Address
+	Phones    []Phone
+	Addresses []PostalAddress
+
+	// Birthday and Anniversary are dates, stored as midnight in the user's
+	// time zone.
+	Birthday    time.Time
+	Anniversary time.Time
+	Spouse      string
+	Children    []string
+	Gender      int // One of the Gender* constants.
+
+	HomePage         string
+	BusinessHomePage string
+	Notes            string
+}
+
+// Phone is a phone or fax number of a contact.
+type Phone struct {
+	Type   int // One of the Phone* constants.
+	Number string
+}
+
+// PostalAddress is a home, business, or other address of a contact.
+type PostalAddress struct {
+	Type          int // One of the Address* constants.
+	Street        string
+	PostOfficeBox string
+	City          string
+	State         string
+	PostalCode    string
+	Country       string
+}
+
+// Phone number types, as stored in Phone.Type.
+const (
+	PhonePrimary = iota
+	PhoneBusiness
+	PhoneBusiness2
+	PhoneHome
+	PhoneHome2
+	PhoneMobile
+	PhoneCar
+	PhoneRadio
+	PhonePager
+	PhoneCallback
+	PhoneAssistant
+	PhoneOther
+	PhoneISDN
+	PhoneTelex
+	PhonePrimaryFax
+	PhoneBusinessFax
+	PhoneHomeFax
+)
+
+// Postal address types, as stored in PostalAddress.Type.
+const (
+	AddressBusiness = iota
+	AddressHome
+	AddressOther
+)
+
+// Genders, as stored in Contact.Gender.
+const (
+	GenderUnspecified = 0x0000
+	GenderFemale      = 0x0001
+	GenderMale        = 0x0002
+)
+
+// Named properties in PSETIDAddress; see MS-OXOCNTC.
+const (
+	lidWorkAddressStreet        = 0x8045
+	lidWorkAddressCity          = 0x8046
+	lidWorkAddressState         = 0x8047
+	lidWorkAddressPostalCode    = 0x8048
+	lidWorkAddressCountry       = 0x8049
+	lidWorkAddressPostOfficeBox = 0x804A
+	lidEmail1DisplayName        = 0x8080
+	lidEmail1AddressType        = 0x8082
+	lidEmail1EmailAddress       = 0x8083
+
+	// The Email2 and Email3 properties follow at the same offsets.
+	lidEmailStride = 0x0010
+)
+
+// Properties with the phone numbers, in the order they're added to
+// Contact.Phones.
+var phoneProps = []struct{ name, typ int }{
+	{MAPIPrimaryTelephoneNumber, PhonePrimary},
+	{MAPIBusinessTelephoneNumber, PhoneBusiness},
+	{MAPIBusiness2TelephoneNumber, PhoneBusiness2},
+	{MAPIHomeTelephoneNumber, PhoneHome},
+	{MAPIHome2TelephoneNumber, PhoneHome2},
+	{MAPIMobileTelephoneNumber, PhoneMobile},
+	{MAPICarTelephoneNumber, PhoneCar},
+	{MAPIRadioTelephoneNumber, PhoneRadio},
+	{MAPIPagerTelephoneNumber, PhonePager},
+	{MAPICallbackTelephoneNumber, PhoneCallback},
+	{MAPIAssistantTelephoneNumber, PhoneAssistant},
+	{MAPIOtherTelephoneNumber, PhoneOther},
+	{MAPIIsdnNumber, PhoneISDN},
+	{MAPITelexNumber, PhoneTelex},
+	{MAPIPrimaryFaxNumber, PhonePrimaryFax},
+	{MAPIBusinessFaxNumber, PhoneBusinessFax},
+	{MAPIHomeFaxNumber, PhoneHomeFax},
+}
+
+// Contact gets the information of a contact, or nil if the message isn't one
+// (based on the MessageClass).
+func (d *Data) Contact() *Contact {
+	class := strings.ToLower(d.MessageClass)
+	if class != "ipm.contact" && !strings.HasPrefix(class, "ipm.contact.") {
+		return nil
+	}
+
+	str := func(name int) string {
+		attr, _ := findAttr(d.Attributes, name)
+		s, _ := attr.StringValue()
+		return s
+	}
+	named := func(id int32) string {
+		attr, _ := findNamed(d.Attributes, PSETIDAddress, id)
+		s, _ := attr.StringValue()
+		return s
+	}
+
+	text, _ := d.TextBody()
+	c := &Contact{
+		UID:              d.MessageID,
+		DisplayName:      str(MAPIDisplayName),
+		Prefix:           str(MAPIDisplayNamePrefix),
+		GivenName:        str(MAPIGivenName),
+		MiddleName:       str(MAPIMiddleName),
+		Surname:          str(MAPISurname),
+		Suffix:           str(MAPIGeneration),
+		Nickname:         str(MAPINickname),
+		Initials:         str(MAPIInitials),
+		Company:          str(MAPICompanyName),
+		Department:       str(MAPIDepartmentName),
+		Title:            str(MAPITitle),
+		OfficeLocation:   str(MAPIOfficeLocation),
+		Profession:       str(MAPIProfession),
+		Manager:          str(MAPIManagerName),
+		Assistant:        str(MAPIAssistant),
+		Spouse:           str(MAPISpouseName),
+		HomePage:         str(MAPIPersonalHomePage),
+		BusinessHomePage: str(MAPIBusinessHomePage),
+		Notes:            text,
+	}
+	if c.DisplayName == "" {
+		// The subject is the display name of the contact.
+		c.DisplayName = d.Subject
+	}
+
+	if attr, ok := findAttr(d.Attributes, MAPIBirthday); ok {
+		c.Birthday, _ = attr.Time()
+	}
+	if attr, ok := findAttr(d.Attributes, MAPIWeddingAnniversary); ok {
+		c.Anniversary, _ = attr.Time()
+	}
+	if attr, ok := findAttr(d.Attributes, MAPIGender); ok {
+		c.Gender, _ = attr.Int()
+	}
+	if attr, ok := findAttr(d.Attributes, MAPIChildrensNames); ok {
+		c.Children, _ = attr.Strings()
+	}
+
+	for i := int32(0); i < 3; i++ {
+		off := i * lidEmailStride
+		addr := Address{
+			Name:        named(lidEmail1DisplayName + off),
+			AddressType: named(lidEmail1AddressType + off),
+			Email:       named(lidEmail1EmailAddress + off),
+		}
+		if addr.Email != "" {
+			c.Emails = append(c.Emails, addr)
+		}
+	}
+
+	for _, p := range phoneProps {
+		if n := str(p.name); n != "" {
+			c.Phones = append(c.Phones, Phone{Type: p.typ, Number: n})
+		}
+	}
+
+	// The business address is in named properties; the "mailing address"
+	// properties contain one of the addresses, and are used if the business
+	// address isn't set.
+	work := PostalAddress{
+		Type:          AddressBusiness,
+		Street:        named(lidWorkAddressStreet),
+		PostOfficeBox: named(lidWorkAddressPostOfficeBox),
+		City:          named(lidWorkAddressCity),
+		State:         named(lidWorkAddressState),
+		PostalCode:    named(lidWorkAddressPostalCode),
+		Country:       named(lidWorkAddressCountry),
+	}
+	if work == (PostalAddress{Type: AddressBusiness}) {
+		work = PostalAddress{
+			Type:          AddressBusiness,
+			Street:        str(MAPIStreetAddress),
+			PostOfficeBox: str(MAPIPostOfficeBox),
+			City:          str(MAPILocality),
+			State:         str(MAPIStateOrProvince),
+			PostalCode:    str(MAPIPostalCode),
+			Country:       str(MAPICountry),
+		}
+	}
+	home := PostalAddress{
+		Type:          AddressHome,
+		Street:        str(MAPIHomeAddressStreet),
+		PostOfficeBox: str(MAPIHomeAddressPostOfficeBox),
+		City:          str(MAPIHomeAddressCity),
+		State:         str(MAPIHomeAddressStateOrProvince),
+		PostalCode:    str(MAPIHomeAddressPostalCode),
+		Country:       str(MAPIHomeAddressCountry),
+	}
+	other := PostalAddress{
+		Type:          AddressOther,
+		Street:        str(MAPIOtherAddressStreet),
+		PostOfficeBox: str(MAPIOtherAddressPostOfficeBox),
+		City:          str(MAPIOtherAddressCity),
+		State:         str(MAPIOtherAddressStateOrProvince),
+		PostalCode:    str(MAPIOtherAddressPostalCode),
+		Country:       str(MAPIOtherAddressCountry),
+	}
+	for _, a := range []PostalAddress{work, home, other} {
+		if a != (PostalAddress{Type: a.Type}) {
+			c.Addresses = append(c.Addresses, a)
+		}
+	}
+
+	return c
+}
@@ -0,0 +1,136 @@
+package tnef
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testContact creates an IPM.Contact with the properties read by Contact.
+func testContact() *Data {
+	str := func(name int, s string) MAPIAttribute {
+		return MAPIAttribute{Type: szmapiUnicodeString, Name: name, Data: encodeUnicode(s)}
+	}
+	named := func(id int32, s string) MAPIAttribute {
+		return MAPIAttribute{Type: szmapiUnicodeString, Name: int(id), Data: encodeUnicode(s),
+			PropSet: PSETIDAddress, NamedID: id}
+	}
+
+	return &Data{
+		MessageClass: "IPM.Contact",
+		MessageID:    "0123ABCD",
+		Subject:      "Alice Smith",
+		Body:         []byte("Met at the conference\x00"),
+		Attributes: []MAPIAttribute{
+			str(MAPIGivenName, "Alice"),
+			str(MAPISurname, "Smith"),
+			str(MAPIDisplayNamePrefix, "Dr."),
+			str(MAPICompanyName, "Example, Inc."),
+			str(MAPITitle, "Engineer"),
+			str(MAPIBusinessTelephoneNumber, "+1 555 0100"),
+			str(MAPIMobileTelephoneNumber, "+1 555 0101"),
+			str(MAPIBusinessFaxNumber, "+1 555 0102"),
+			str(MAPIHomeAddressStreet, "1 Main St"),
+			str(MAPIHomeAddressCity, "Springfield"),
+			str(MAPIHomeAddressPostalCode, "12345"),
+			str(MAPISpouseName, "Bob"),
+			{Type: szmapiShort, Name: MAPIGender, Data: le32(GenderFemale)},
+			// Midnight in UTC+2.
+			{Type: szmapiSystime, Name: MAPIBirthday, Data: filetime(date(1980, 5, 16, 22, 0))},
+			named(lidEmail1DisplayName, "Alice Smith (alice@example.com)"),
+			named(lidEmail1AddressType, "SMTP"),
+			named(lidEmail1EmailAddress, "alice@example.com"),
+			named(lidEmail1DisplayName+lidEmailStride, "Alice Smith"),
+			named(lidEmail1AddressType+lidEmailStride, "EX"),
+			named(lidEmail1EmailAddress+lidEmailStride, "/o=Example/cn=alice"),
+			named(lidWorkAddressStreet, "2 Work Rd"),
+			named(lidWorkAddressCity, "Shelbyville"),
+		},
+	}
+}
+
+func TestContact(t *testing.T) {
+	c := testContact().Contact()
+	if c == nil {
+		t.Fatal("Contact is nil")
+	}
+
+	if c.DisplayName != "Alice Smith" {
+		t.Errorf("wrong DisplayName: %q", c.DisplayName)
+	}
+	if c.GivenName != "Alice" || c.Surname != "Smith" || c.Prefix != "Dr." {
+		t.Errorf("wrong name: %q %q %q", c.Prefix, c.GivenName, c.Surname)
+	}
+	if c.Gender != GenderFemale {
+		t.Errorf("wrong Gender: %d", c.Gender)
+	}
+
+	wantEmails := []Address{
+		{Name: "Alice Smith (alice@example.com)", AddressType: "SMTP", Email: "alice@example.com"},
+		{Name: "Alice Smith", AddressType: "EX", Email: "/o=Example/cn=alice"},
+	}
+	if !reflect.DeepEqual(c.Emails, wantEmails) {
+		t.Errorf("wrong Emails: %#v", c.Emails)
+	}
+	wantPhones := []Phone{
+		{PhoneBusiness, "+1 555 0100"},
+		{PhoneMobile, "+1 555 0101"},
+		{PhoneBusinessFax, "+1 555 0102"},
+	}
+	if !reflect.DeepEqual(c.Phones, wantPhones) {
+		t.Errorf("wrong Phones: %#v", c.Phones)
+	}
+	wantAddresses := []PostalAddress{
+		{Type: AddressBusiness, Street: "2 Work Rd", City: "Shelbyville"},
+		{Type: AddressHome, Street: "1 Main St", City: "Springfield", PostalCode: "12345"},
+	}
+	if !reflect.DeepEqual(c.Addresses, wantAddresses) {
+		t.Errorf("wrong Addresses: %#v", c.Addresses)
+	}
+
+	if c := (&Data{MessageClass: "IPM.Note"}).Contact(); c != nil {
+		t.Errorf("Contact is not nil: %#v", c)
+	}
+}
+
+func TestWriteVCard(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := testContact().Contact().WriteVCard(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := strings.Replace(`BEGIN:VCARD
+VERSION:4.0
+PRODID:-//Teamwork//tnef//EN
+UID:0123ABCD
+FN:Alice Smith
+N:Smith;Alice;;Dr.;
+GENDER:F
+BDAY:19800517
+ORG:Example\, Inc.
+TITLE:Engineer
+EMAIL;PREF=1:alice@example.com
+TEL;VALUE=text;TYPE=work,voice:+1 555 0100
+TEL;VALUE=text;TYPE=cell:+1 555 0101
+TEL;VALUE=text;TYPE=work,fax:+1 555 0102
+ADR;TYPE=work:;;2 Work Rd;Shelbyville;;;
+ADR;TYPE=home:;;1 Main St;Springfield;;12345;
+RELATED;VALUE=text;TYPE=spouse:Bob
+NOTE:Met at the conference
+END:VCARD
+`, "\n", "\r\n", -1)
+	if got := buf.String(); got != want {
+		t.Errorf("\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	// vCard has no TYPE for telex numbers.
+	buf.Reset()
+	c := &Contact{DisplayName: "Telex", Phones: []Phone{{Type: PhoneTelex, Number: "12345"}}}
+	if err := c.WriteVCard(buf); err != nil {
+		t.Fatal(err)
+	}
+	if want := "\r\nTEL;VALUE=text:12345\r\n"; !strings.Contains(buf.String(), want) {
+		t.Errorf("no %q in:\n%s", want, buf.String())
+	}
+}
@@ -0,0 +1,113 @@
+package tnef
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// contentWriter writes the "content lines" used by iCalendar (RFC 5545) and
+// vCard (RFC 6350).
+type contentWriter struct {
+	w   io.Writer
+	err error
+}
+
+// Lines are folded after this many octets, excluding the line break.
+const contentLineLength = 75
+
+// prop writes a property with the value as-is; params should be formatted
+// with param.
+func (c *contentWriter) prop(name, value string, params ...string) {
+	if c.err != nil {
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString(name)
+	for _, p := range params {
+		b.WriteByte(';')
+		b.WriteString(p)
+	}
+	b.WriteByte(':')
+	b.WriteString(value)
+
+	_, c.err = io.WriteString(c.w, foldLine(b.String()))
+}
+
+// text writes a property with a text value, which is escaped.
+func (c *contentWriter) text(name, value string, params ...string) {
+	c.prop(name, escapeText(value), params...)
+}
+
+// foldLine folds a content line so that no line is longer than
+// contentLineLength octets, without splitting UTF-8 sequences, and adds the
+// CRLF.
+func foldLine(line string) string {
+	var b strings.Builder
+	max := contentLineLength
+	for len(line) > max {
+		i := max
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+		if i == 0 {
+			// Not valid UTF-8; split anywhere.
+			i = max
+		}
+		b.WriteString(line[:i])
+		b.WriteString("\r\n ")
+		line = line[i:]
+		// The space at the start of continuation lines counts.
+		max = contentLineLength - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`;`, `\;`,
+	`,`, `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// escapeText escapes a TEXT value.
+func escapeText(s string) string {
+	return textEscaper.Replace(strings.TrimRight(s, "\x00"))
+}
+
+// Double quotes and line breaks can't be escaped in parameter values.
+var paramEscaper = strings.NewReplacer(`"`, "'", "\r", " ", "\n", " ")
+
+// param formats a property parameter, quoting the value if needed.
+func param(name, value string) string {
+	return paramList(name, value)
+}
+
+// paramList formats a property parameter with a list of values, such as
+// TYPE=work,voice; every value is quoted separately if needed.
+func paramList(name string, values ...string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		v = paramEscaper.Replace(v)
+		if strings.ContainsAny(v, ":;,") {
+			v = `"` + v + `"`
+		}
+		quoted[i] = v
+	}
+	return name + "=" + strings.Join(quoted, ",")
+}
+
+// structured writes a property with a structured text value, such as the N
+// and ADR properties of a vCard. The components are escaped and separated by
+// semicolons.
+func (c *contentWriter) structured(name string, components []string, params ...string) {
+	for i, s := range components {
+		components[i] = escapeText(s)
+	}
+	c.prop(name, strings.Join(components, ";"), params...)
+}
@@ -0,0 +1,256 @@
+package tnef
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// Size of the fixed part of a TNEF object: level (1), name (2), type (2) and
+// length (4).
+const objectHeaderSize = 9
+
+// Decoder reads TNEF objects one at a time from an input stream, so that large
+// files can be processed without holding the whole file (or all the attachment
+// data) in memory.
+type Decoder struct {
+	// Options for decoding; these should be set before the first call to
+	// Next.
+	Options DecodeOptions
+
+	r        *bufio.Reader
+	offset   int64 // Number of bytes read from r.
+	started  bool
+	obj      *Object
+	err      error
+	warnings []error
+	depth    int // Nesting depth of embedded messages.
+	codepage int // From ATTOEMCODEPAGE, for Object.Attributes.
+}
+
+// Object is a single TNEF attribute as read by a Decoder.
+//
+// The attribute data is not read in to memory; it can be read through the
+// Object's Read method until the next call to Decoder.Next.
+type Object struct {
+	Offset int64 // Offset of the object in the TNEF data.
+	Level  int
+	Name   int
+	Type   int
+	Length int // Length of the data in bytes.
+
+	d         *Decoder
+	remaining int
+	sum       uint16 // Checksum of the data read so far.
+	checked   bool   // Checksum was read and verified.
+}
+
+// NewDecoder creates a new Decoder reading from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// Next advances to the next object in the stream. Any data of the previous
+// object that was not read is skipped.
+//
+// It will return io.EOF if there are no more objects.
+func (d *Decoder) Next() (*Object, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	if !d.started {
+		d.started = true
+		if err := d.readSignature(); err != nil {
+			d.err = err
+			return nil, err
+		}
+	}
+
+	if d.obj != nil {
+		if err := d.obj.finish(); err != nil {
+			d.err = err
+			return nil, err
+		}
+		d.obj = nil
+	}
+
+	obj, err := d.readObject()
+	if err != nil {
+		d.err = err
+		return nil, err
+	}
+
+	d.obj = obj
+	return obj, nil
+}
+
+func (d *Decoder) readSignature() error {
+	// Signature (4 bytes) followed by the legacy key (2 bytes), which we don't
+	// use.
+	buf := make([]byte, 6)
+	n, err := io.ReadFull(d.r, buf)
+	d.offset += int64(n)
+	if err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return ErrNoMarker
+		}
+		return err
+	}
+
+	if byteToInt(buf[0:4]) != tnefSignature {
+		return ErrNoMarker
+	}
+	return nil
+}
+
+func (d *Decoder) readObject() (*Object, error) {
+	offset := d.offset
+	buf := make([]byte, objectHeaderSize)
+	n, err := io.ReadFull(d.r, buf)
+	d.offset += int64(n)
+	if err != nil {
+		// Some clients add a few bytes of padding after the last object;
+		// that's not large enough to be an object, so just ignore it.
+		if err == io.ErrUnexpectedEOF {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+
+	obj := &Object{
+		Offset: offset,
+		Level:  byteToInt(buf[0:1]),
+		Name:   byteToInt(buf[1:3]),
+		Type:   byteToInt(buf[3:5]),
+		Length: byteToInt(buf[5:9]),
+		d:      d,
+	}
+	obj.remaining = obj.Length
+
+	if obj.Level != lvlMessage && obj.Level != lvlAttachment {
+		return nil, obj.errorf(0, "invalid level %d", obj.Level)
+	}
+
+	// Peek at the code page, so that it's known to Attributes even if the
+	// data of this object isn't read.
+	if obj.Name == ATTOEMCODEPAGE && obj.Length >= 4 {
+		if b, err := d.r.Peek(4); err == nil {
+			d.codepage = byteToInt(b)
+		}
+	}
+	return obj, nil
+}
+
+// errorf returns a FormatError for the data at offset off in the object.
+func (o *Object) errorf(off int, format string, a ...interface{}) *FormatError {
+	return &FormatError{
+		Offset: o.Offset + objectHeaderSize + int64(off),
+		Attr:   o.Name,
+		Msg:    fmt.Sprintf(format, a...),
+	}
+}
+
+// Read reads the object's data.
+func (o *Object) Read(p []byte) (int, error) {
+	if o.remaining <= 0 {
+		return 0, io.EOF
+	}
+	if len(p) > o.remaining {
+		p = p[:o.remaining]
+	}
+
+	n, err := o.d.r.Read(p)
+	o.remaining -= n
+	o.d.offset += int64(n)
+	for _, b := range p[:n] {
+		o.sum += uint16(b)
+	}
+	if err == io.EOF {
+		err = o.errorf(o.Length-o.remaining, "unexpected end of data; object is %d bytes", o.Length)
+	}
+
+	// Verify the checksum as soon as all the data is read, so that readers
+	// get an error rather than io.EOF in strict mode.
+	if err == nil && o.remaining == 0 {
+		err = o.checksum()
+	}
+	return n, err
+}
+
+// Bytes reads the remainder of the object's data in to memory.
+func (o *Object) Bytes() ([]byte, error) {
+	return ioutil.ReadAll(o)
+}
+
+// Attributes reads the remainder of the data of an ATTMAPIPROPS or
+// ATTATTACHMENT object and decodes the MAPI properties in it. 8-bit strings are
+// in the code page of an earlier ATTOEMCODEPAGE object.
+//
+// Embedded messages aren't decoded; in lenient mode problems with the
+// properties are added to the warnings of the Decoder.
+func (o *Object) Attributes() ([]MAPIAttribute, error) {
+	base := o.Offset + objectHeaderSize + int64(o.Length-o.remaining)
+	data, err := o.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := decodeMapi(data, base, o.d)
+	if err != nil {
+		return nil, err
+	}
+	cp := o.d.codepage
+	if cp == 0 {
+		cp = mapiCodepage(attrs)
+	}
+	setCodepage(attrs, cp)
+	return attrs, nil
+}
+
+// finish skips over any unread data and verifies the checksum.
+func (o *Object) finish() error {
+	if o.remaining > 0 {
+		if _, err := io.Copy(ioutil.Discard, o); err != nil {
+			return err
+		}
+	}
+	return o.checksum()
+}
+
+// checksum reads the checksum after the data and compares it to the checksum
+// of the data that was read.
+//
+// A mismatch is an error in strict mode, and a warning otherwise.
+func (o *Object) checksum() error {
+	if o.checked {
+		return nil
+	}
+	o.checked = true
+
+	buf := make([]byte, 2)
+	n, err := io.ReadFull(o.d.r, buf)
+	o.d.offset += int64(n)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return o.errorf(o.Length, "unexpected end of data; missing checksum")
+	}
+	if err != nil {
+		return err
+	}
+
+	if want := uint16(byteToInt(buf)); want != o.sum {
+		err := o.errorf(o.Length, "checksum mismatch: got 0x%04x, want 0x%04x", o.sum, want)
+		err.Err = ErrChecksum
+		if o.d.Options.Strict {
+			return err
+		}
+		o.d.warnings = append(o.d.warnings, err)
+	}
+	return nil
+}
+
+// Warnings returns the problems found so far that didn't stop the decoding,
+// such as checksum mismatches in lenient mode.
+func (d *Decoder) Warnings() []error {
+	return d.warnings
+}
@@ -0,0 +1,113 @@
+package tnef
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/teamwork/test"
+)
+
+func TestDecoder(t *testing.T) {
+	fp, err := os.Open("./testdata/two-files.tnef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fp.Close() // nolint: errcheck
+
+	var got [][]byte
+	d := NewDecoder(fp)
+	for {
+		obj, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if obj.Name != ATTATTACHDATA {
+			continue
+		}
+
+		buf := new(bytes.Buffer)
+		n, err := io.Copy(buf, obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int(n) != obj.Length {
+			t.Errorf("read %d bytes; want %d", n, obj.Length)
+		}
+		got = append(got, buf.Bytes())
+	}
+
+	want, err := Decode(test.Read(t, "./testdata", "two-files.tnef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want.Attachments) {
+		t.Fatalf("wrong length; want %v, got %v", len(want.Attachments), len(got))
+	}
+	for i := range got {
+		if !bytes.Equal(got[i], want.Attachments[i].Data) {
+			t.Errorf("attachment %d: data differs", i)
+		}
+	}
+}
+
+func TestDecoderNoMarker(t *testing.T) {
+	for _, in := range []string{"", "\x78\x9f", "not a TNEF file"} {
+		_, err := NewDecoder(bytes.NewReader([]byte(in))).Next()
+		if err != ErrNoMarker {
+			t.Errorf("%q: wrong err\ngot:  %v\nwant: %v", in, err, ErrNoMarker)
+		}
+	}
+}
+
+func TestDecoderSkip(t *testing.T) {
+	// Don't read any of the data; Next should skip it.
+	d := NewDecoder(bytes.NewReader(test.Read(t, "./testdata", "attachments.tnef")))
+	n := 0
+	for {
+		_, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		n++
+	}
+	if n != 18 {
+		t.Errorf("wrong number of objects: %d", n)
+	}
+}
+
+func TestDecoderAttributes(t *testing.T) {
+	data := test.Read(t, "./testdata", "triples.tnef")
+	want, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []MAPIAttribute
+	d := NewDecoder(bytes.NewReader(data))
+	for {
+		obj, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if obj.Name == ATTMAPIPROPS {
+			if got, err = obj.Attributes(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if !reflect.DeepEqual(got, want.Attributes) {
+		t.Errorf("attributes differ\ngot:  %#v\nwant: %#v", got, want.Attributes)
+	}
+}
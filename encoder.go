@@ -0,0 +1,343 @@
+package tnef
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+const (
+	// Legacy key written after the signature; readers don't use it.
+	legacyKey = 0x0001
+
+	tnefVersion     = 0x00010000
+	defaultCodepage = 1252
+	defaultClass    = "IPM.Microsoft Mail.Note"
+)
+
+// Encode writes d to w in the TNEF format.
+//
+// The message class, typed fields such as Subject and SentAt, recipients, body,
+// and MAPI attributes are written as message attributes, followed by the title,
+// data, and MAPI attributes of every attachment. Embedded messages are written
+// as a nested TNEF stream; ErrMaxDepth is returned if they're nested deeper
+// than DefaultMaxDepth (e.g. because a message embeds itself).
+//
+// The typed fields are written as legacy TNEF attributes, which store dates
+// without a time zone; they're written in UTC. MAPI properties in Attributes
+// take precedence over the typed fields when the data is decoded again.
+func Encode(w io.Writer, d *Data) error {
+	return encode(w, d, 0)
+}
+
+// encode writes d to w; depth is the nesting depth of embedded messages.
+func encode(w io.Writer, d *Data, depth int) error {
+	if depth > DefaultMaxDepth {
+		return ErrMaxDepth
+	}
+	e := &encoder{w: w}
+
+	e.uint32(tnefSignature)
+	e.uint16(legacyKey)
+
+	e.object(lvlMessage, ATTTNEFVERSION, atpDword, le32(tnefVersion))
+	// Legacy attributes are written in the code page of the message; that's
+	// also what 8-bit strings in the MAPI attributes are decoded with.
+	cp := d.Codepage
+	if charsetName(cp) == "" {
+		cp = defaultCodepage
+	}
+	e.object(lvlMessage, ATTOEMCODEPAGE, atpByte, append(le32(uint32(cp)), le32(0)...))
+
+	class := []byte(defaultClass)
+	if d.MessageClass != "" {
+		class = encodeCodepage(cp, d.MessageClass)
+	}
+	for _, attr := range d.Attributes {
+		if attr.Name == MAPIMessageClass {
+			if s, err := attr.StringValue(); err == nil {
+				class = encodeCodepage(cp, strings.TrimRight(s, "\x00"))
+			}
+		}
+	}
+	e.object(lvlMessage, ATTMESSAGECLASS, atpWord, append(class, 0))
+
+	// The typed fields are written as the legacy attributes; when decoding
+	// they're overridden by the MAPI properties in Attributes.
+	if d.From != (Address{}) {
+		e.object(lvlMessage, ATTFROM, atpTriples, encodeTriple(cp, d.From))
+	}
+	if d.Subject != "" {
+		e.object(lvlMessage, ATTSUBJECT, atpString, append(encodeCodepage(cp, d.Subject), 0))
+	}
+	if !d.SentAt.IsZero() {
+		e.object(lvlMessage, ATTDATESENT, atpDate, encodeDTR(d.SentAt))
+	}
+	if !d.ReceivedAt.IsZero() {
+		e.object(lvlMessage, ATTDATERECD, atpDate, encodeDTR(d.ReceivedAt))
+	}
+	if d.Priority != PriorityUnknown {
+		e.object(lvlMessage, ATTPRIORITY, atpShort, le16(uint16(d.Priority)))
+	}
+	if d.MessageID != "" {
+		e.object(lvlMessage, ATTMESSAGEID, atpString, append(encodeCodepage(cp, d.MessageID), 0))
+	}
+
+	if len(d.Recipients) > 0 {
+		e.object(lvlMessage, ATTRECIPTABLE, atpByte, encodeRecipients(d.Recipients))
+	}
+	e.object(lvlMessage, ATTMAPIPROPS, atpByte, encodeMapi(messageAttributes(d)))
+
+	for _, a := range d.Attachments {
+		attrs, err := attachmentAttributes(a, depth)
+		if err != nil {
+			return err
+		}
+		e.object(lvlAttachment, ATTATTACHRENDDATA, atpByte, renderData())
+		e.object(lvlAttachment, ATTATTACHTITLE, atpString, append(encodeCodepage(cp, a.Title), 0))
+		e.object(lvlAttachment, ATTATTACHDATA, atpByte, a.Data)
+		e.object(lvlAttachment, ATTATTACHMENT, atpByte, encodeMapi(attrs))
+	}
+
+	return e.err
+}
+
+// messageAttributes gets the MAPI attributes to write for the message, adding
+// the body if it's not in the attributes.
+//
+// The body is written as 8-bit text in the code page of the message, as it's
+// stored in Body, unless it was decapsulated from the RTF body: that's UTF-8,
+// so it's written as a Unicode string.
+func messageAttributes(d *Data) []MAPIAttribute {
+	var hasBody, hasHTML bool
+	for _, attr := range d.Attributes {
+		switch attr.Name {
+		case MAPIBody:
+			hasBody = true
+		case MAPIBodyHTML:
+			hasHTML = true
+		}
+	}
+
+	// Copy so that appending doesn't modify the backing array of d.Attributes.
+	attrs := append([]MAPIAttribute(nil), d.Attributes...)
+	switch {
+	case hasBody || len(d.Body) == 0:
+	case d.textFromRTF:
+		attrs = append(attrs, MAPIAttribute{Type: szmapiUnicodeString, Name: MAPIBody, Data: encodeUnicode(string(d.Body))})
+	default:
+		attrs = append(attrs, MAPIAttribute{Type: szmapiString, Name: MAPIBody, Data: d.Body})
+	}
+	switch {
+	case hasHTML || len(d.BodyHTML) == 0:
+	case d.htmlFromRTF:
+		attrs = append(attrs, MAPIAttribute{Type: szmapiUnicodeString, Name: MAPIHTML, Data: encodeUnicode(string(d.BodyHTML))})
+	default:
+		attrs = append(attrs, MAPIAttribute{Type: szmapiBinary, Name: MAPIHTML, Data: d.BodyHTML})
+	}
+	return attrs
+}
+
+// attachmentAttributes gets the MAPI attributes to write for the attachment,
+// adding the typed fields if they're not in the attributes. The embedded
+// message is encoded at depth+1.
+func attachmentAttributes(a *Attachment, depth int) ([]MAPIAttribute, error) {
+	has := make(map[int]bool)
+	for _, attr := range a.Attributes {
+		has[attr.Name] = true
+	}
+
+	// Copy so that appending doesn't modify the backing array of a.Attributes.
+	attrs := append([]MAPIAttribute(nil), a.Attributes...)
+	add := func(name int, value string) {
+		if !has[name] && value != "" {
+			attrs = append(attrs, MAPIAttribute{Type: szmapiUnicodeString, Name: name, Data: encodeUnicode(value)})
+		}
+	}
+	add(MAPIAttachLongFilename, a.LongFilename)
+	add(MAPIAttachMimeTag, a.MimeType)
+	add(MAPIAttachContentID, a.ContentID)
+	method := a.Method
+	if !has[MAPIAttachDataObj] && a.Embedded != nil {
+		buf := new(bytes.Buffer)
+		// Writing to a bytes.Buffer can't fail, so this is only ErrMaxDepth.
+		if err := encode(buf, a.Embedded, depth+1); err != nil {
+			return nil, err
+		}
+		attrs = append(attrs, MAPIAttribute{
+			Type: szmapiObject,
+			Name: MAPIAttachDataObj,
+			Data: append(append([]byte{}, iidIMessage...), buf.Bytes()...),
+		})
+		if method == AttachNone {
+			method = AttachEmbeddedMsg
+		}
+	}
+	if !has[MAPIAttachMethod] && method != AttachNone {
+		attrs = append(attrs, MAPIAttribute{Type: szmapiInt, Name: MAPIAttachMethod, Data: le32(uint32(method))})
+	}
+	return attrs, nil
+}
+
+// encodeTriple encodes addr as the "triple" read by decodeTriple, with the
+// strings in the code page cp.
+func encodeTriple(cp int, addr Address) []byte {
+	name := append(encodeCodepage(cp, addr.Name), 0)
+	email := addr.Email
+	if addr.AddressType != "" {
+		email = addr.AddressType + ":" + email
+	}
+	address := append(encodeCodepage(cp, email), 0)
+
+	b := append(le16(0x0004), le16(uint16(8+len(name)+len(address)))...)
+	b = append(b, le16(uint16(len(name)))...)
+	b = append(b, le16(uint16(len(address)))...)
+	b = append(b, name...)
+	b = append(b, address...)
+	// Terminated by an empty triple.
+	return append(b, make([]byte, 8)...)
+}
+
+// encodeDTR encodes t in UTC in the DTR format read by decodeDTR.
+func encodeDTR(t time.Time) []byte {
+	t = t.UTC()
+	var b []byte
+	for _, v := range []int{t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second(), int(t.Weekday())} {
+		b = append(b, le16(uint16(v))...)
+	}
+	return b
+}
+
+// encodeUnicode encodes s as a NUL-terminated UTF-16 string.
+func encodeUnicode(s string) []byte {
+	u := utf16.Encode([]rune(s + "\x00"))
+	b := make([]byte, 0, len(u)*2)
+	for _, c := range u {
+		b = append(b, le16(c)...)
+	}
+	return b
+}
+
+// renderData creates the ATTATTACHRENDDATA for a file attachment.
+func renderData() []byte {
+	var b []byte
+	b = append(b, le16(0x0001)...)     // Attachment type: file.
+	b = append(b, le32(0xffffffff)...) // Position in the body: none.
+	b = append(b, le16(0xffff)...)     // Width of the icon: default.
+	b = append(b, le16(0xffff)...)     // Height of the icon: default.
+	b = append(b, le32(0)...)          // Flags.
+	return b
+}
+
+// encodeMapi encodes attrs as a block of MAPI properties, in the format read
+// by decodeMapi.
+func encodeMapi(attrs []MAPIAttribute) []byte {
+	var b []byte
+	var n uint32
+	for _, attr := range attrs {
+		typeSize := getTypeSize(attr.Type)
+		if typeSize == 0 {
+			continue
+		}
+
+		// Data is used for single values, so that it can be modified without
+		// having to update Values as well.
+		typ, values := attr.Type, [][]byte{attr.Data}
+		if attr.IsMultiValue {
+			typ, values = typ|mvFlag, attr.Values
+		}
+
+		b = append(b, le16(uint16(typ))...)
+		b = append(b, le16(uint16(attr.Name))...)
+		if attr.Name >= 0x8000 {
+			b = appendNamed(b, attr)
+		}
+		// Variable-sized values are always preceded by the number of values.
+		if attr.IsMultiValue || typeSize < 0 {
+			b = append(b, le32(uint32(len(values)))...)
+		}
+		for _, v := range values {
+			if typeSize < 0 {
+				b = append(b, le32(uint32(len(v)))...)
+			} else {
+				fixed := make([]byte, typeSize)
+				copy(fixed, v)
+				v = fixed
+			}
+			b = appendPadded(b, v)
+		}
+		n++
+	}
+
+	return append(le32(n), b...)
+}
+
+// appendNamed appends the property set and ID or name of a named property.
+func appendNamed(b []byte, attr MAPIAttribute) []byte {
+	b = append(b, attr.PropSet[:]...)
+	if attr.NamedString == "" {
+		b = append(b, le32(namedKindID)...)
+		return append(b, le32(uint32(attr.NamedID))...)
+	}
+
+	name := encodeUnicode(attr.NamedString)
+	b = append(b, le32(namedKindString)...)
+	b = append(b, le32(uint32(len(name)))...)
+	return appendPadded(b, name)
+}
+
+// appendPadded appends v to b, padded with zeroes to a multiple of 4 bytes.
+func appendPadded(b, v []byte) []byte {
+	b = append(b, v...)
+	return append(b, make([]byte, -len(v)&3)...)
+}
+
+type encoder struct {
+	w   io.Writer
+	err error
+}
+
+func (e *encoder) write(b []byte) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.Write(b)
+}
+
+func (e *encoder) uint16(v uint16) { e.write(le16(v)) }
+func (e *encoder) uint32(v uint32) { e.write(le32(v)) }
+
+// object writes a TNEF object, in the format read by Decoder.
+func (e *encoder) object(level, name, typ int, data []byte) {
+	e.write([]byte{byte(level)})
+	e.uint16(uint16(name))
+	e.uint16(uint16(typ))
+	e.uint32(uint32(len(data)))
+	e.write(data)
+	e.uint16(checksum(data))
+}
+
+// checksum calculates the checksum of an object's data: the sum of all bytes,
+// modulo 65536.
+func checksum(data []byte) uint16 {
+	var sum uint16
+	for _, b := range data {
+		sum += uint16(b)
+	}
+	return sum
+}
+
+func le16(v uint16) []byte {
+	b := make([]byte, 2)
+	binary.LittleEndian.PutUint16(b, v)
+	return b
+}
+
+func le32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return b
+}
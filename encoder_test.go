@@ -0,0 +1,262 @@
+package tnef
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/teamwork/test"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []string{
+		"attachments",
+		"body",
+		"data-before-name",
+		"multi-name-property",
+		"multi-value-attribute",
+		"one-file",
+		"triples",
+		"two-files",
+		"unicode-mapi-attr",
+		"unicode-mapi-attr-name",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			want, err := Decode(test.Read(t, "./testdata", tt+".tnef"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			buf := new(bytes.Buffer)
+			if err := Encode(buf, want); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := Decode(buf.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// A body decapsulated from the RTF is written as a Unicode
+			// string, so only the text is the same.
+			if want.textFromRTF {
+				gotText, _ := got.TextBody()
+				wantText, _ := want.TextBody()
+				if gotText != wantText {
+					t.Errorf("wrong TextBody\ngot:  %q\nwant: %q", gotText, wantText)
+				}
+			} else if !bytes.Equal(got.Body, want.Body) {
+				t.Errorf("wrong Body\ngot:  %q\nwant: %q", got.Body, want.Body)
+			}
+			if want.htmlFromRTF {
+				gotHTML, _ := got.HTMLBody()
+				wantHTML, _ := want.HTMLBody()
+				if gotHTML != wantHTML {
+					t.Errorf("wrong HTMLBody\ngot:  %q\nwant: %q", gotHTML, wantHTML)
+				}
+			} else if !bytes.Equal(got.BodyHTML, want.BodyHTML) {
+				t.Errorf("wrong BodyHTML\ngot:  %q\nwant: %q", got.BodyHTML, want.BodyHTML)
+			}
+
+			if !reflect.DeepEqual(got.Recipients, want.Recipients) {
+				t.Errorf("wrong Recipients\ngot:  %#v\nwant: %#v", got.Recipients, want.Recipients)
+			}
+
+			if len(got.Attachments) != len(want.Attachments) {
+				t.Fatalf("wrong number of attachments; want %v, got %v",
+					len(want.Attachments), len(got.Attachments))
+			}
+			for i := range want.Attachments {
+				if !reflect.DeepEqual(got.Attachments[i], want.Attachments[i]) {
+					t.Errorf("attachment %d differs\ngot:  %#v\nwant: %#v",
+						i, got.Attachments[i], want.Attachments[i])
+				}
+			}
+
+			// The body may be added as an attribute if it was decoded from
+			// the RTF.
+			gotAttrs := got.Attributes
+			if len(want.BodyRTF) > 0 {
+				gotAttrs = nil
+				for _, attr := range got.Attributes {
+					if attr.Name != MAPIBody && attr.Name != MAPIBodyHTML {
+						gotAttrs = append(gotAttrs, attr)
+					}
+				}
+			}
+
+			if !reflect.DeepEqual(gotAttrs, want.Attributes) {
+				t.Errorf("attributes differ\ngot:  %#v\nwant: %#v", gotAttrs, want.Attributes)
+			}
+		})
+	}
+}
+
+func TestEncodeMessageClass(t *testing.T) {
+	want, err := Decode(test.Read(t, "./testdata", "unicode-mapi-attr-name.tnef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	if err := Encode(buf, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Decode(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.MessageClass != "IPM.Note" {
+		t.Errorf("wrong MessageClass: %q", got.MessageClass)
+	}
+
+	// PR_MESSAGE_CLASS is Unicode; ATTMESSAGECLASS is in the code page of the
+	// message.
+	dec := NewDecoder(bytes.NewReader(buf.Bytes()))
+	for {
+		obj, err := dec.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if obj.Name != ATTMESSAGECLASS {
+			continue
+		}
+		class, err := obj.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(class) != "IPM.Note\x00" {
+			t.Errorf("wrong ATTMESSAGECLASS: %q", class)
+		}
+		break
+	}
+}
+
+func TestEncodeChecksum(t *testing.T) {
+	if got := checksum([]byte{0xff, 0xff, 0x03}); got != 0x0201 {
+		t.Errorf("wrong checksum: 0x%04x", got)
+	}
+
+	buf := new(bytes.Buffer)
+	err := Encode(buf, &Data{
+		Body:        []byte("Hello\x00"),
+		Attachments: []*Attachment{{Title: "a.txt", Data: []byte{0xff, 0xff, 0x03}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = DecodeWithOptions(buf.Bytes(), DecodeOptions{Strict: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEncodeFields(t *testing.T) {
+	want := &Data{
+		Subject:      "Zażółć gęślą jaźń",
+		From:         Address{Name: "Alice", AddressType: "SMTP", Email: "alice@example.com"},
+		SentAt:       time.Date(2020, 3, 4, 12, 30, 15, 0, time.UTC),
+		ReceivedAt:   time.Date(2020, 3, 4, 12, 31, 0, 0, time.UTC),
+		Priority:     PriorityHigh,
+		MessageID:    "C326F5735704184D96EBD387444C618B",
+		MessageClass: "IPM.Note",
+		Codepage:     1250,
+	}
+	buf := new(bytes.Buffer)
+	if err := Encode(buf, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Decode(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Subject != want.Subject {
+		t.Errorf("wrong Subject: %q", got.Subject)
+	}
+	if got.From != want.From {
+		t.Errorf("wrong From: %#v", got.From)
+	}
+	if !got.SentAt.Equal(want.SentAt) {
+		t.Errorf("wrong SentAt: %v", got.SentAt)
+	}
+	if !got.ReceivedAt.Equal(want.ReceivedAt) {
+		t.Errorf("wrong ReceivedAt: %v", got.ReceivedAt)
+	}
+	if got.Priority != want.Priority {
+		t.Errorf("wrong Priority: %d", got.Priority)
+	}
+	if got.MessageID != want.MessageID {
+		t.Errorf("wrong MessageID: %q", got.MessageID)
+	}
+
+	// MAPI properties take precedence.
+	want.Attributes = []MAPIAttribute{{Type: szmapiUnicodeString, Name: MAPISubject, Data: encodeUnicode("MAPI")}}
+	buf.Reset()
+	if err := Encode(buf, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err = Decode(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Subject != "MAPI" {
+		t.Errorf("wrong Subject: %q", got.Subject)
+	}
+}
+
+func TestEncodeEmbedded(t *testing.T) {
+	inner := &Data{Subject: "inner"}
+	outer := &Data{Attachments: []*Attachment{{Title: "inner", Embedded: inner}}}
+	buf := new(bytes.Buffer)
+	if err := Encode(buf, outer); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Decode(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := got.Attachments[0].Embedded; e == nil || e.Subject != "inner" {
+		t.Fatalf("wrong Embedded: %#v", e)
+	}
+
+	// A message that embeds itself.
+	inner.Attachments = []*Attachment{{Embedded: inner}}
+	err = Encode(new(bytes.Buffer), inner)
+	if err != ErrMaxDepth {
+		t.Fatalf("wrong error: %v", err)
+	}
+}
+
+func TestEncodeAttributes(t *testing.T) {
+	// Appending the body mustn't write in to the backing array of Attributes.
+	attrs := make([]MAPIAttribute, 1, 2)
+	attrs[0] = MAPIAttribute{Type: szmapiInt, Name: MAPIInternetCPID, Data: le32(65001)}
+	d := &Data{Body: []byte("Hello\x00"), Attributes: attrs}
+	if err := Encode(new(bytes.Buffer), d); err != nil {
+		t.Fatal(err)
+	}
+	if extra := attrs[:2][1]; extra.Name != 0 {
+		t.Errorf("Attributes modified: %#v", extra)
+	}
+
+	// The body decapsulated from the RTF is UTF-8.
+	d = &Data{Body: []byte("Zażółć\x00"), BodyHTML: []byte("<p>gęślą</p>"), textFromRTF: true, htmlFromRTF: true}
+	buf := new(bytes.Buffer)
+	if err := Encode(buf, d); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Decode(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text, _ := got.TextBody(); text != "Zażółć" {
+		t.Errorf("wrong TextBody: %q", text)
+	}
+	if html, _ := got.HTMLBody(); html != "<p>gęślą</p>" {
+		t.Errorf("wrong HTMLBody: %q", html)
+	}
+}
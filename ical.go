@@ -0,0 +1,339 @@
+package tnef
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PRODID of the iCalendar data we write.
+const icalProdID = "-//Teamwork//tnef//EN"
+
+const (
+	icalDateTimeFormat = "20060102T150405Z"
+	icalDateFormat     = "20060102"
+)
+
+// WriteICalendar writes the appointment as an iCalendar (RFC 5545) VCALENDAR
+// with a single VEVENT, using Method as the METHOD.
+//
+// Times are written in UTC. All-day events are written as dates, which
+// assumes that the time zone of the organizer is within 12 hours of UTC.
+//
+// Recurring appointments are written with an RRULE and EXDATEs, and a VEVENT
+// with a RECURRENCE-ID for every modified occurrence. An error is returned if
+// the recurrence pattern can't be decoded. If it can't be converted to an
+// RRULE (ErrUnsupportedRecurrence) only the first occurrence is written, and
+// the error is recorded in Warnings.
+func (a *Appointment) WriteICalendar(w io.Writer) error {
+	var (
+		r       *RecurrencePattern
+		rule    string
+		exdates []time.Time
+	)
+	a.Warnings = nil
+	if a.Recurring && len(a.Recurrence) > 0 {
+		var err error
+		if r, err = decodeRecurrence(a.Recurrence, a.codepage); err != nil {
+			return err
+		}
+		rule, exdates, err = r.rrule(a.Start, a.AllDay)
+		switch {
+		case errors.Is(err, ErrUnsupportedRecurrence):
+			a.Warnings = append(a.Warnings, err)
+			r = nil
+		case err != nil:
+			return err
+		}
+	}
+
+	return writeCalendar(w, a.Method, func(c *contentWriter) {
+		a.writeEvent(c, rule, exdates)
+		if r == nil {
+			return
+		}
+
+		offset := r.utcOffset(a.Start)
+		for _, e := range r.Exceptions {
+			o := *a
+			o.Start, o.End = e.Start.Add(-offset), e.End.Add(-offset)
+			if e.Subject != "" {
+				o.Subject = e.Subject
+			}
+			if e.Location != "" {
+				o.Location = e.Location
+			}
+			o.recurrenceID = e.OriginalStart.Add(-offset)
+			o.writeEvent(c, "", nil)
+		}
+	})
+}
+
+// writeCalendar writes a VCALENDAR; component is called to write the
+// components in it.
+func writeCalendar(w io.Writer, method string, component func(c *contentWriter)) error {
+	c := &contentWriter{w: w}
+	c.prop("BEGIN", "VCALENDAR")
+	c.prop("PRODID", icalProdID)
+	c.prop("VERSION", "2.0")
+	if method != "" {
+		c.prop("METHOD", method)
+	}
+	component(c)
+	c.prop("END", "VCALENDAR")
+	return c.err
+}
+
+func (a *Appointment) writeEvent(c *contentWriter, rule string, exdates []time.Time) {
+	c.prop("BEGIN", "VEVENT")
+
+	uid := a.UID
+	if uid == "" {
+		// UID is required, so make one up that is the same every time.
+		h := sha1.Sum([]byte(a.Subject + "\x00" + a.Start.String()))
+		uid = strings.ToUpper(hex.EncodeToString(h[:]))
+	}
+	c.text("UID", uid)
+	stamp := a.SentAt
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	c.prop("DTSTAMP", icalTime(stamp))
+	c.prop("SEQUENCE", strconv.Itoa(a.Sequence))
+
+	a.timeProp(c, "DTSTART", a.Start)
+	a.timeProp(c, "DTEND", a.End)
+	if rule != "" {
+		c.prop("RRULE", rule)
+	}
+	for _, t := range exdates {
+		a.timeProp(c, "EXDATE", t)
+	}
+	a.timeProp(c, "RECURRENCE-ID", a.recurrenceID)
+
+	if a.Subject != "" {
+		c.text("SUMMARY", a.Subject)
+	}
+	if a.Location != "" {
+		c.text("LOCATION", a.Location)
+	}
+	if a.Description != "" {
+		c.text("DESCRIPTION", a.Description)
+	}
+
+	if a.Organizer != (Address{}) {
+		c.prop("ORGANIZER", icalAddress(a.Organizer, ""), icalName(a.Organizer)...)
+	}
+	for _, r := range a.Attendees {
+		if r.Type == RecipientOriginator {
+			continue
+		}
+		params := icalName(r.Address)
+		params = append(params, attendeeParams(r, a.Method == "REQUEST" && a.ResponseRequested)...)
+		c.prop("ATTENDEE", icalAddress(r.Address, r.SMTPAddress), params...)
+	}
+
+	if a.Method == "CANCEL" {
+		c.prop("STATUS", "CANCELLED")
+	}
+	if a.BusyStatus == BusyFree {
+		c.prop("TRANSP", "TRANSPARENT")
+	} else {
+		c.prop("TRANSP", "OPAQUE")
+	}
+	if s, ok := busyStatusNames[a.BusyStatus]; ok {
+		c.prop("X-MICROSOFT-CDO-BUSYSTATUS", s)
+	}
+
+	c.prop("END", "VEVENT")
+}
+
+// timeProp writes a date-time property, or a date property for all-day
+// events. Nothing is written if t is zero.
+func (a *Appointment) timeProp(c *contentWriter, name string, t time.Time) {
+	switch {
+	case t.IsZero():
+	case a.AllDay:
+		c.prop(name, icalDate(t), "VALUE=DATE")
+	default:
+		c.prop(name, icalTime(t))
+	}
+}
+
+var busyStatusNames = map[int]string{
+	BusyFree:             "FREE",
+	BusyTentative:        "TENTATIVE",
+	BusyBusy:             "BUSY",
+	BusyOutOfOffice:      "OOF",
+	BusyWorkingElsewhere: "WORKINGELSEWHERE",
+}
+
+// attendeeParams gets the ROLE, CUTYPE, PARTSTAT and RSVP parameters for an
+// attendee.
+func attendeeParams(r Recipient, rsvp bool) []string {
+	var params []string
+	switch r.Type {
+	case RecipientCc:
+		params = append(params, "ROLE=OPT-PARTICIPANT")
+	case RecipientBcc:
+		// Outlook adds resources such as rooms as BCC.
+		params = append(params, "ROLE=NON-PARTICIPANT", "CUTYPE=RESOURCE")
+	default:
+		params = append(params, "ROLE=REQ-PARTICIPANT")
+	}
+
+	switch r.TrackStatus {
+	case ResponseAccepted:
+		params = append(params, "PARTSTAT=ACCEPTED")
+	case ResponseDeclined:
+		params = append(params, "PARTSTAT=DECLINED")
+	case ResponseTentative:
+		params = append(params, "PARTSTAT=TENTATIVE")
+	default:
+		params = append(params, "PARTSTAT=NEEDS-ACTION")
+	}
+
+	if rsvp {
+		params = append(params, "RSVP=TRUE")
+	}
+	return params
+}
+
+// icalName gets the CN parameter for the address, if it has a name.
+func icalName(addr Address) []string {
+	if addr.Name == "" {
+		return nil
+	}
+	return []string{param("CN", addr.Name)}
+}
+
+// icalAddress gets the address as a mailto: URI. Outlook uses invalid:nomail
+// for addresses without an email address, such as Exchange addresses.
+func icalAddress(addr Address, smtp string) string {
+	email := smtp
+	if email == "" && strings.Contains(addr.Email, "@") {
+		email = addr.Email
+	}
+	if email == "" {
+		return "invalid:nomail"
+	}
+	return "mailto:" + email
+}
+
+func icalTime(t time.Time) string {
+	return t.UTC().Format(icalDateTimeFormat)
+}
+
+// icalDate gets the date of an all-day event. Outlook stores these as midnight
+// in the organizer's time zone, so round to the nearest day in UTC.
+func icalDate(t time.Time) string {
+	return t.UTC().Add(12 * time.Hour).Truncate(24 * time.Hour).Format(icalDateFormat)
+}
+
+// WriteICalendar writes the task as an iCalendar (RFC 5545) VCALENDAR with a
+// single VTODO, using Method as the METHOD.
+//
+// The start and due dates are written as dates, and recurring tasks are
+// written with an RRULE. An error is returned if the recurrence pattern can't
+// be decoded; like for appointments, a pattern that can't be converted is
+// recorded in Warnings and the task is written without an RRULE.
+func (t *Task) WriteICalendar(w io.Writer) error {
+	var rule string
+	t.Warnings = nil
+	if t.Recurring && len(t.Recurrence) > 0 {
+		r, err := decodeRecurrence(t.Recurrence, t.codepage)
+		if err != nil {
+			return err
+		}
+		rule, _, err = r.rrule(t.Start, true)
+		switch {
+		case errors.Is(err, ErrUnsupportedRecurrence):
+			t.Warnings = append(t.Warnings, err)
+		case err != nil:
+			return err
+		}
+	}
+
+	return writeCalendar(w, t.Method, func(c *contentWriter) {
+		c.prop("BEGIN", "VTODO")
+
+		uid := t.UID
+		if uid == "" {
+			h := sha1.Sum([]byte(t.Subject + "\x00" + t.Due.String()))
+			uid = strings.ToUpper(hex.EncodeToString(h[:]))
+		}
+		c.text("UID", uid)
+		stamp := t.SentAt
+		if stamp.IsZero() {
+			stamp = time.Now()
+		}
+		c.prop("DTSTAMP", icalTime(stamp))
+
+		if !t.Start.IsZero() {
+			c.prop("DTSTART", icalDate(t.Start), "VALUE=DATE")
+		}
+		if !t.Due.IsZero() {
+			c.prop("DUE", icalDate(t.Due), "VALUE=DATE")
+		}
+		if rule != "" {
+			c.prop("RRULE", rule)
+		}
+		if !t.Completed.IsZero() {
+			c.prop("COMPLETED", icalTime(t.Completed))
+		}
+
+		if t.Subject != "" {
+			c.text("SUMMARY", t.Subject)
+		}
+		if t.Description != "" {
+			c.text("DESCRIPTION", t.Description)
+		}
+
+		switch {
+		case t.Complete || t.Status == TaskComplete:
+			c.prop("STATUS", "COMPLETED")
+		case t.Status == TaskInProgress || t.Status == TaskWaiting:
+			c.prop("STATUS", "IN-PROCESS")
+		default:
+			c.prop("STATUS", "NEEDS-ACTION")
+		}
+		percent := int(t.PercentComplete*100 + 0.5)
+		if t.Complete {
+			percent = 100
+		}
+		if percent > 0 && percent <= 100 {
+			c.prop("PERCENT-COMPLETE", strconv.Itoa(percent))
+		}
+		if p, ok := taskPriorities[t.Priority]; ok {
+			c.prop("PRIORITY", p)
+		}
+
+		if t.Method != "PUBLISH" {
+			if t.Organizer != (Address{}) {
+				c.prop("ORGANIZER", icalAddress(t.Organizer, ""), icalName(t.Organizer)...)
+			}
+			for _, r := range t.Attendees {
+				if r.Type == RecipientOriginator {
+					continue
+				}
+				params := icalName(r.Address)
+				params = append(params, attendeeParams(r, false)...)
+				c.prop("ATTENDEE", icalAddress(r.Address, r.SMTPAddress), params...)
+			}
+		}
+
+		c.prop("END", "VTODO")
+	})
+}
+
+// taskPriorities maps the message priority to the iCalendar PRIORITY, where 1
+// is the highest and 9 the lowest.
+var taskPriorities = map[int]string{
+	PriorityHigh:   "1",
+	PriorityNormal: "5",
+	PriorityLow:    "9",
+}
@@ -0,0 +1,500 @@
+// Code generated by mkproptags.go; DO NOT EDIT.
+
+package tnef
+
+// We can use these constants to find specific types
+// of MAPIAttribute by comparing it to the type of the
+// attribute.
+const (
+	MAPIAcknowledgementMode                   = 0x0001 // PR_ACKNOWLEDGEMENT_MODE
+	MAPIAlternateRecipientAllowed             = 0x0002 // PidTagAlternateRecipientAllowed
+	MAPIAuthorizingUsers                      = 0x0003 // PR_AUTHORIZING_USERS
+	MAPIAutoForwardComment                    = 0x0004 // PidTagAutoForwardComment
+	MAPIAutoForwarded                         = 0x0005 // PidTagAutoForwarded
+	MAPIContentConfidentialityAlgorithmID     = 0x0006 // PR_CONTENT_CONFIDENTIALITY_ALGORITHM_ID
+	MAPIContentCorrelator                     = 0x0007 // PR_CONTENT_CORRELATOR
+	MAPIContentIdentifier                     = 0x0008 // PR_CONTENT_IDENTIFIER
+	MAPIContentLength                         = 0x0009 // PR_CONTENT_LENGTH
+	MAPIContentReturnRequested                = 0x000A // PR_CONTENT_RETURN_REQUESTED
+	MAPIConversationKey                       = 0x000B // PR_CONVERSATION_KEY
+	MAPIConversionEits                        = 0x000C // PR_CONVERSION_EITS
+	MAPIConversionWithLossProhibited          = 0x000D // PR_CONVERSION_WITH_LOSS_PROHIBITED
+	MAPIConvertedEits                         = 0x000E // PR_CONVERTED_EITS
+	MAPIDeferredDeliveryTime                  = 0x000F // PidTagDeferredDeliveryTime
+	MAPIDeliverTime                           = 0x0010 // PidTagDeliverTime
+	MAPIDiscardReason                         = 0x0011 // PR_DISCARD_REASON
+	MAPIDisclosureOfRecipients                = 0x0012 // PR_DISCLOSURE_OF_RECIPIENTS
+	MAPIDlExpansionHistory                    = 0x0013 // PR_DL_EXPANSION_HISTORY
+	MAPIDlExpansionProhibited                 = 0x0014 // PR_DL_EXPANSION_PROHIBITED
+	MAPIExpiryTime                            = 0x0015 // PidTagExpiryTime
+	MAPIImplicitConversionProhibited          = 0x0016 // PR_IMPLICIT_CONVERSION_PROHIBITED
+	MAPIImportance                            = 0x0017 // PidTagImportance
+	MAPIIpmID                                 = 0x0018 // PR_IPM_ID
+	MAPILatestDeliveryTime                    = 0x0019 // PR_LATEST_DELIVERY_TIME
+	MAPIMessageClass                          = 0x001A // PidTagMessageClass
+	MAPIMessageDeliveryID                     = 0x001B // PR_MESSAGE_DELIVERY_ID
+	MAPIMessageSecurityLabel                  = 0x001E // PR_MESSAGE_SECURITY_LABEL
+	MAPIObsoletedIpms                         = 0x001F // PR_OBSOLETED_IPMS
+	MAPIOriginallyIntendedRecipientName       = 0x0020 // PR_ORIGINALLY_INTENDED_RECIPIENT_NAME
+	MAPIOriginalEits                          = 0x0021 // PR_ORIGINAL_EITS
+	MAPIOriginatorCertificate                 = 0x0022 // PR_ORIGINATOR_CERTIFICATE
+	MAPIOriginatorDeliveryReportRequested     = 0x0023 // PidTagOriginatorDeliveryReportRequested
+	MAPIOriginatorReturnAddress               = 0x0024 // PR_ORIGINATOR_RETURN_ADDRESS
+	MAPIParentKey                             = 0x0025 // PidTagParentKey
+	MAPIPriority                              = 0x0026 // PidTagPriority
+	MAPIOriginCheck                           = 0x0027 // PR_ORIGIN_CHECK
+	MAPIProofOfSubmissionRequested            = 0x0028 // PR_PROOF_OF_SUBMISSION_REQUESTED
+	MAPIReadReceiptRequested                  = 0x0029 // PidTagReadReceiptRequested
+	MAPIReceiptTime                           = 0x002A // PidTagReceiptTime
+	MAPIRecipientReassignmentProhibited       = 0x002B // PidTagRecipientReassignmentProhibited
+	MAPIRedirectionHistory                    = 0x002C // PR_REDIRECTION_HISTORY
+	MAPIRelatedIpms                           = 0x002D // PR_RELATED_IPMS
+	MAPIOriginalSensitivity                   = 0x002E // PidTagOriginalSensitivity
+	MAPILanguages                             = 0x002F // PR_LANGUAGES
+	MAPIReplyTime                             = 0x0030 // PidTagReplyTime
+	MAPIReportTag                             = 0x0031 // PidTagReportTag
+	MAPIReportTime                            = 0x0032 // PidTagReportTime
+	MAPIReturnedIpm                           = 0x0033 // PR_RETURNED_IPM
+	MAPISecurity                              = 0x0034 // PR_SECURITY
+	MAPIIncompleteCopy                        = 0x0035 // PidTagIncompleteCopy
+	MAPISensitivity                           = 0x0036 // PidTagSensitivity
+	MAPISubject                               = 0x0037 // PidTagSubject
+	MAPISubjectIpm                            = 0x0038 // PR_SUBJECT_IPM
+	MAPIClientSubmitTime                      = 0x0039 // PidTagClientSubmitTime
+	MAPIReportName                            = 0x003A // PidTagReportName
+	MAPISentRepresentingSearchKey             = 0x003B // PidTagSentRepresentingSearchKey
+	MAPIX400ContentType                       = 0x003C // PR_X400_CONTENT_TYPE
+	MAPISubjectPrefix                         = 0x003D // PidTagSubjectPrefix
+	MAPINonReceiptReason                      = 0x003E // PR_NON_RECEIPT_REASON
+	MAPIReceivedByEntryID                     = 0x003F // PidTagReceivedByEntryId
+	MAPIReceivedByName                        = 0x0040 // PidTagReceivedByName
+	MAPISentRepresentingEntryID               = 0x0041 // PidTagSentRepresentingEntryId
+	MAPISentRepresentingName                  = 0x0042 // PidTagSentRepresentingName
+	MAPIRcvdRepresentingEntryID               = 0x0043 // PidTagReceivedRepresentingEntryId
+	MAPIRcvdRepresentingName                  = 0x0044 // PidTagReceivedRepresentingName
+	MAPIReportEntryID                         = 0x0045 // PidTagReportEntryId
+	MAPIReadReceiptEntryID                    = 0x0046 // PidTagReadReceiptEntryId
+	MAPIMessageSubmissionID                   = 0x0047 // PidTagMessageSubmissionId
+	MAPIProviderSubmitTime                    = 0x0048 // PidTagProviderSubmitTime
+	MAPIOriginalSubject                       = 0x0049 // PidTagOriginalSubject
+	MAPIDiscVal                               = 0x004A // PR_DISC_VAL
+	MAPIOrigMessageClass                      = 0x004B // PidTagOriginalMessageClass
+	MAPIOriginalAuthorEntryID                 = 0x004C // PidTagOriginalAuthorEntryId
+	MAPIOriginalAuthorName                    = 0x004D // PidTagOriginalAuthorName
+	MAPIOriginalSubmitTime                    = 0x004E // PidTagOriginalSubmitTime
+	MAPIReplyRecipientEntries                 = 0x004F // PidTagReplyRecipientEntries
+	MAPIReplyRecipientNames                   = 0x0050 // PidTagReplyRecipientNames
+	MAPIReceivedBySearchKey                   = 0x0051 // PidTagReceivedBySearchKey
+	MAPIRcvdRepresentingSearchKey             = 0x0052 // PidTagReceivedRepresentingSearchKey
+	MAPIReadReceiptSearchKey                  = 0x0053 // PidTagReadReceiptSearchKey
+	MAPIReportSearchKey                       = 0x0054 // PidTagReportSearchKey
+	MAPIOriginalDeliveryTime                  = 0x0055 // PidTagOriginalDeliveryTime
+	MAPIOriginalAuthorSearchKey               = 0x0056 // PR_ORIGINAL_AUTHOR_SEARCH_KEY
+	MAPIMessageToMe                           = 0x0057 // PidTagMessageToMe
+	MAPIMessageCcMe                           = 0x0058 // PidTagMessageCcMe
+	MAPIMessageRecipMe                        = 0x0059 // PidTagMessageRecipientMe
+	MAPIOriginalSenderName                    = 0x005A // PidTagOriginalSenderName
+	MAPIOriginalSenderEntryID                 = 0x005B // PidTagOriginalSenderEntryId
+	MAPIOriginalSenderSearchKey               = 0x005C // PidTagOriginalSenderSearchKey
+	MAPIOriginalSentRepresentingName          = 0x005D // PidTagOriginalSentRepresentingName
+	MAPIOriginalSentRepresentingEntryID       = 0x005E // PidTagOriginalSentRepresentingEntryId
+	MAPIOriginalSentRepresentingSearchKey     = 0x005F // PidTagOriginalSentRepresentingSearchKey
+	MAPIStartDate                             = 0x0060 // PidTagStartDate
+	MAPIEndDate                               = 0x0061 // PidTagEndDate
+	MAPIOwnerApptID                           = 0x0062 // PidTagOwnerAppointmentId
+	MAPIResponseRequested                     = 0x0063 // PidTagResponseRequested
+	MAPISentRepresentingAddrtype              = 0x0064 // PidTagSentRepresentingAddressType
+	MAPISentRepresentingEmailAddress          = 0x0065 // PidTagSentRepresentingEmailAddress
+	MAPIOriginalSenderAddrtype                = 0x0066 // PidTagOriginalSenderAddressType
+	MAPIOriginalSenderEmailAddress            = 0x0067 // PidTagOriginalSenderEmailAddress
+	MAPIOriginalSentRepresentingAddrtype      = 0x0068 // PidTagOriginalSentRepresentingAddressType
+	MAPIOriginalSentRepresentingEmailAddress  = 0x0069 // PidTagOriginalSentRepresentingEmailAddress
+	MAPIConversationTopic                     = 0x0070 // PidTagConversationTopic
+	MAPIConversationIndex                     = 0x0071 // PidTagConversationIndex
+	MAPIOriginalDisplayBcc                    = 0x0072 // PidTagOriginalDisplayBcc
+	MAPIOriginalDisplayCc                     = 0x0073 // PidTagOriginalDisplayCc
+	MAPIOriginalDisplayTo                     = 0x0074 // PidTagOriginalDisplayTo
+	MAPIReceivedByAddrtype                    = 0x0075 // PidTagReceivedByAddressType
+	MAPIReceivedByEmailAddress                = 0x0076 // PidTagReceivedByEmailAddress
+	MAPIRcvdRepresentingAddrtype              = 0x0077 // PidTagReceivedRepresentingAddressType
+	MAPIRcvdRepresentingEmailAddress          = 0x0078 // PidTagReceivedRepresentingEmailAddress
+	MAPIOriginalAuthorAddrtype                = 0x0079 // PR_ORIGINAL_AUTHOR_ADDRTYPE
+	MAPIOriginalAuthorEmailAddress            = 0x007A // PR_ORIGINAL_AUTHOR_EMAIL_ADDRESS
+	MAPIOriginallyIntendedRecipAddrtype       = 0x007B // PR_ORIGINALLY_INTENDED_RECIP_ADDRTYPE
+	MAPIOriginallyIntendedRecipEmailAddress   = 0x007C // PR_ORIGINALLY_INTENDED_RECIP_EMAIL_ADDRESS
+	MAPITransportMessageHeaders               = 0x007D // PidTagTransportMessageHeaders
+	MAPIDelegation                            = 0x007E // PR_DELEGATION
+	MAPITnefCorrelationKey                    = 0x007F // PidTagTnefCorrelationKey
+	MAPIContentIntegrityCheck                 = 0x0C00 // PR_CONTENT_INTEGRITY_CHECK
+	MAPIExplicitConversion                    = 0x0C01 // PR_EXPLICIT_CONVERSION
+	MAPIIpmReturnRequested                    = 0x0C02 // PR_IPM_RETURN_REQUESTED
+	MAPIMessageToken                          = 0x0C03 // PR_MESSAGE_TOKEN
+	MAPINdrReasonCode                         = 0x0C04 // PidTagNonDeliveryReportReasonCode
+	MAPINdrDiagCode                           = 0x0C05 // PidTagNonDeliveryReportDiagCode
+	MAPINonReceiptNotificationRequested       = 0x0C06 // PidTagNonReceiptNotificationRequested
+	MAPIDeliveryPoint                         = 0x0C07 // PR_DELIVERY_POINT
+	MAPIOriginatorNonDeliveryReportRequested  = 0x0C08 // PidTagOriginatorNonDeliveryReportRequested
+	MAPIOriginatorRequestedAlternateRecipient = 0x0C09 // PR_ORIGINATOR_REQUESTED_ALTERNATE_RECIPIENT
+	MAPIPhysicalDeliveryBureauFaxDelivery     = 0x0C0A // PR_PHYSICAL_DELIVERY_BUREAU_FAX_DELIVERY
+	MAPIPhysicalDeliveryMode                  = 0x0C0B // PR_PHYSICAL_DELIVERY_MODE
+	MAPIPhysicalDeliveryReportRequest         = 0x0C0C // PR_PHYSICAL_DELIVERY_REPORT_REQUEST
+	MAPIPhysicalForwardingAddress             = 0x0C0D // PR_PHYSICAL_FORWARDING_ADDRESS
+	MAPIPhysicalForwardingAddressRequested    = 0x0C0E // PR_PHYSICAL_FORWARDING_ADDRESS_REQUESTED
+	MAPIPhysicalForwardingProhibited          = 0x0C0F // PR_PHYSICAL_FORWARDING_PROHIBITED
+	MAPIPhysicalRenditionAttributes           = 0x0C10 // PR_PHYSICAL_RENDITION_ATTRIBUTES
+	MAPIProofOfDelivery                       = 0x0C11 // PR_PROOF_OF_DELIVERY
+	MAPIProofOfDeliveryRequested              = 0x0C12 // PR_PROOF_OF_DELIVERY_REQUESTED
+	MAPIRecipientCertificate                  = 0x0C13 // PR_RECIPIENT_CERTIFICATE
+	MAPIRecipientNumberForAdvice              = 0x0C14 // PR_RECIPIENT_NUMBER_FOR_ADVICE
+	MAPIRecipientType                         = 0x0C15 // PidTagRecipientType
+	MAPIRegisteredMailType                    = 0x0C16 // PR_REGISTERED_MAIL_TYPE
+	MAPIReplyRequested                        = 0x0C17 // PidTagReplyRequested
+	MAPIRequestedDeliveryMethod               = 0x0C18 // PR_REQUESTED_DELIVERY_METHOD
+	MAPISenderEntryID                         = 0x0C19 // PidTagSenderEntryId
+	MAPISenderName                            = 0x0C1A // PidTagSenderName
+	MAPISupplementaryInfo                     = 0x0C1B // PidTagSupplementaryInfo
+	MAPITypeOfMtsUser                         = 0x0C1C // PR_TYPE_OF_MTS_USER
+	MAPISenderSearchKey                       = 0x0C1D // PidTagSenderSearchKey
+	MAPISenderAddrtype                        = 0x0C1E // PidTagSenderAddressType
+	MAPISenderEmailAddress                    = 0x0C1F // PidTagSenderEmailAddress
+	MAPINdrStatusCode                         = 0x0C20 // PidTagNonDeliveryReportStatusCode
+	MAPICurrentVersion                        = 0x0E00 // PR_CURRENT_VERSION
+	MAPIDeleteAfterSubmit                     = 0x0E01 // PidTagDeleteAfterSubmit
+	MAPIDisplayBcc                            = 0x0E02 // PidTagDisplayBcc
+	MAPIDisplayCc                             = 0x0E03 // PidTagDisplayCc
+	MAPIDisplayTo                             = 0x0E04 // PidTagDisplayTo
+	MAPIParentDisplay                         = 0x0E05 // PR_PARENT_DISPLAY
+	MAPIMessageDeliveryTime                   = 0x0E06 // PidTagMessageDeliveryTime
+	MAPIMessageFlags                          = 0x0E07 // PidTagMessageFlags
+	MAPIMessageSize                           = 0x0E08 // PidTagMessageSize
+	MAPIParentEntryID                         = 0x0E09 // PidTagParentEntryId
+	MAPISentmailEntryID                       = 0x0E0A // PR_SENTMAIL_ENTRYID
+	MAPICorrelate                             = 0x0E0C // PR_CORRELATE
+	MAPICorrelateMtsID                        = 0x0E0D // PR_CORRELATE_MTSID
+	MAPIDiscreteValues                        = 0x0E0E // PR_DISCRETE_VALUES
+	MAPIResponsibility                        = 0x0E0F // PidTagResponsibility
+	MAPISpoolerStatus                         = 0x0E10 // PR_SPOOLER_STATUS
+	MAPITransportStatus                       = 0x0E11 // PR_TRANSPORT_STATUS
+	MAPIMessageRecipients                     = 0x0E12 // PidTagMessageRecipients
+	MAPIMessageAttachments                    = 0x0E13 // PidTagMessageAttachments
+	MAPISubmitFlags                           = 0x0E14 // PR_SUBMIT_FLAGS
+	MAPIRecipientStatus                       = 0x0E15 // PR_RECIPIENT_STATUS
+	MAPITransportKey                          = 0x0E16 // PR_TRANSPORT_KEY
+	MAPIMsgStatus                             = 0x0E17 // PidTagMessageStatus
+	MAPIMessageDownloadTime                   = 0x0E18 // PR_MESSAGE_DOWNLOAD_TIME
+	MAPICreationVersion                       = 0x0E19 // PR_CREATION_VERSION
+	MAPIModifyVersion                         = 0x0E1A // PR_MODIFY_VERSION
+	MAPIHasattach                             = 0x0E1B // PidTagHasAttachments
+	MAPIBodyCrc                               = 0x0E1C // PR_BODY_CRC
+	MAPINormalizedSubject                     = 0x0E1D // PidTagNormalizedSubject
+	MAPIRtfInSync                             = 0x0E1F // PidTagRtfInSync
+	MAPIAttachSize                            = 0x0E20 // PidTagAttachSize
+	MAPIAttachNum                             = 0x0E21 // PidTagAttachNumber
+	MAPIPreprocess                            = 0x0E22 // PR_PREPROCESS
+	MAPIInternetArticleNumber                 = 0x0E23 // PidTagInternetArticleNumber
+	MAPIOriginatingMtaCertificate             = 0x0E25 // PR_ORIGINATING_MTA_CERTIFICATE
+	MAPIProofOfSubmission                     = 0x0E26 // PR_PROOF_OF_SUBMISSION
+	MAPIToDoItemFlags                         = 0x0E2B // PidTagToDoItemFlags
+	MAPIRead                                  = 0x0E69 // PidTagRead
+	MAPIAccess                                = 0x0FF4 // PidTagAccess
+	MAPIRowType                               = 0x0FF5 // PidTagRowType
+	MAPIInstanceKey                           = 0x0FF6 // PidTagInstanceKey
+	MAPIAccessLevel                           = 0x0FF7 // PidTagAccessLevel
+	MAPIMappingSignature                      = 0x0FF8 // PidTagMappingSignature
+	MAPIRecordKey                             = 0x0FF9 // PidTagRecordKey
+	MAPIStoreRecordKey                        = 0x0FFA // PidTagStoreRecordKey
+	MAPIStoreEntryID                          = 0x0FFB // PidTagStoreEntryId
+	MAPIMiniIcon                              = 0x0FFC // PR_MINI_ICON
+	MAPIIcon                                  = 0x0FFD // PR_ICON
+	MAPIObjectType                            = 0x0FFE // PidTagObjectType
+	MAPIEntryID                               = 0x0FFF // PidTagEntryId
+	MAPIBody                                  = 0x1000 // PidTagBody
+	MAPIReportText                            = 0x1001 // PidTagReportText
+	MAPIOriginatorAndDlExpansionHistory       = 0x1002 // PR_ORIGINATOR_AND_DL_EXPANSION_HISTORY
+	MAPIReportingDlName                       = 0x1003 // PR_REPORTING_DL_NAME
+	MAPIReportingMtaCertificate               = 0x1004 // PR_REPORTING_MTA_CERTIFICATE
+	MAPIRtfSyncBodyCrc                        = 0x1006 // PidTagRtfSyncBodyCrc
+	MAPIRtfSyncBodyCount                      = 0x1007 // PidTagRtfSyncBodyCount
+	MAPIRtfSyncBodyTag                        = 0x1008 // PidTagRtfSyncBodyTag
+	MAPIRtfCompressed                         = 0x1009 // PidTagRtfCompressed
+	MAPIRtfSyncPrefixCount                    = 0x1010 // PidTagRtfSyncPrefixCount
+	MAPIRtfSyncTrailingCount                  = 0x1011 // PidTagRtfSyncTrailingCount
+	MAPIOriginallyIntendedRecipEntryID        = 0x1012 // PR_ORIGINALLY_INTENDED_RECIP_ENTRYID
+	MAPIHTML                                  = 0x1013 // PidTagHtml
+	MAPIBodyHTML                              = 0x1013 // PidTagBodyHtml
+	MAPIBodyContentLocation                   = 0x1014 // PidTagBodyContentLocation
+	MAPIBodyContentID                         = 0x1015 // PidTagBodyContentId
+	MAPINativeBodyInfo                        = 0x1016 // PidTagNativeBody
+	MAPIInternetApproved                      = 0x1030 // PidTagInternetApproved
+	MAPIInternetMessageID                     = 0x1035 // PidTagInternetMessageId
+	MAPIInternetReferences                    = 0x1039 // PidTagInternetReferences
+	MAPIInReplyToID                           = 0x1042 // PidTagInReplyToId
+	MAPIListHelp                              = 0x1043 // PidTagListHelp
+	MAPIListSubscribe                         = 0x1044 // PidTagListSubscribe
+	MAPIListUnsubscribe                       = 0x1045 // PidTagListUnsubscribe
+	MAPIOriginalMessageID                     = 0x1046 // PidTagOriginalMessageId
+	MAPIIconIndex                             = 0x1080 // PidTagIconIndex
+	MAPILastVerbExecuted                      = 0x1081 // PidTagLastVerbExecuted
+	MAPILastVerbExecutionTime                 = 0x1082 // PidTagLastVerbExecutionTime
+	MAPIFlagStatus                            = 0x1090 // PidTagFlagStatus
+	MAPIFlagCompleteTime                      = 0x1091 // PidTagFlagCompleteTime
+	MAPIFollowupIcon                          = 0x1095 // PidTagFollowupIcon
+	MAPIBlockStatus                           = 0x1096 // PidTagBlockStatus
+	MAPIAttrHidden                            = 0x10F4 // PidTagAttributeHidden
+	MAPIAttrReadonly                          = 0x10F6 // PidTagAttributeReadOnly
+	MAPIRowID                                 = 0x3000 // PidTagRowid
+	MAPIDisplayName                           = 0x3001 // PidTagDisplayName
+	MAPIAddrtype                              = 0x3002 // PidTagAddressType
+	MAPIEmailAddress                          = 0x3003 // PidTagEmailAddress
+	MAPIComment                               = 0x3004 // PidTagComment
+	MAPIDepth                                 = 0x3005 // PidTagDepth
+	MAPIProviderDisplay                       = 0x3006 // PR_PROVIDER_DISPLAY
+	MAPICreationTime                          = 0x3007 // PidTagCreationTime
+	MAPILastModificationTime                  = 0x3008 // PidTagLastModificationTime
+	MAPIResourceFlags                         = 0x3009 // PR_RESOURCE_FLAGS
+	MAPIProviderDllName                       = 0x300A // PR_PROVIDER_DLL_NAME
+	MAPISearchKey                             = 0x300B // PidTagSearchKey
+	MAPIProviderUID                           = 0x300C // PR_PROVIDER_UID
+	MAPIProviderOrdinal                       = 0x300D // PR_PROVIDER_ORDINAL
+	MAPIFormVersion                           = 0x3301 // PR_FORM_VERSION
+	MAPIFormClsid                             = 0x3302 // PR_FORM_CLSID
+	MAPIFormContactName                       = 0x3303 // PR_FORM_CONTACT_NAME
+	MAPIFormCategory                          = 0x3304 // PR_FORM_CATEGORY
+	MAPIFormCategorySub                       = 0x3305 // PR_FORM_CATEGORY_SUB
+	MAPIFormHostMap                           = 0x3306 // PR_FORM_HOST_MAP
+	MAPIFormHidden                            = 0x3307 // PR_FORM_HIDDEN
+	MAPIFormDesignerName                      = 0x3308 // PR_FORM_DESIGNER_NAME
+	MAPIFormDesignerGuID                      = 0x3309 // PR_FORM_DESIGNER_GUID
+	MAPIFormMessageBehavior                   = 0x330A // PR_FORM_MESSAGE_BEHAVIOR
+	MAPIDefaultStore                          = 0x3400 // PR_DEFAULT_STORE
+	MAPIStoreSupportMask                      = 0x340D // PidTagStoreSupportMask
+	MAPIStoreState                            = 0x340E // PidTagStoreState
+	MAPIIpmSubtreeSearchKey                   = 0x3410 // PR_IPM_SUBTREE_SEARCH_KEY
+	MAPIIpmOutboxSearchKey                    = 0x3411 // PR_IPM_OUTBOX_SEARCH_KEY
+	MAPIIpmWastebasketSearchKey               = 0x3412 // PR_IPM_WASTEBASKET_SEARCH_KEY
+	MAPIIpmSentmailSearchKey                  = 0x3413 // PR_IPM_SENTMAIL_SEARCH_KEY
+	MAPIMdbProvider                           = 0x3414 // PR_MDB_PROVIDER
+	MAPIReceiveFolderSettings                 = 0x3415 // PR_RECEIVE_FOLDER_SETTINGS
+	MAPIValidFolderMask                       = 0x35DF // PR_VALID_FOLDER_MASK
+	MAPIIpmSubtreeEntryID                     = 0x35E0 // PR_IPM_SUBTREE_ENTRYID
+	MAPIIpmOutboxEntryID                      = 0x35E2 // PR_IPM_OUTBOX_ENTRYID
+	MAPIIpmWastebasketEntryID                 = 0x35E3 // PR_IPM_WASTEBASKET_ENTRYID
+	MAPIIpmSentmailEntryID                    = 0x35E4 // PR_IPM_SENTMAIL_ENTRYID
+	MAPIViewsEntryID                          = 0x35E5 // PR_VIEWS_ENTRYID
+	MAPICommonViewsEntryID                    = 0x35E6 // PR_COMMON_VIEWS_ENTRYID
+	MAPIFinderEntryID                         = 0x35E7 // PR_FINDER_ENTRYID
+	MAPIContainerFlags                        = 0x3600 // PidTagContainerFlags
+	MAPIFolderType                            = 0x3601 // PidTagFolderType
+	MAPIContentCount                          = 0x3602 // PidTagContentCount
+	MAPIContentUnread                         = 0x3603 // PidTagContentUnreadCount
+	MAPICreateTemplates                       = 0x3604 // PR_CREATE_TEMPLATES
+	MAPIDetailsTable                          = 0x3605 // PR_DETAILS_TABLE
+	MAPISearch                                = 0x3607 // PR_SEARCH
+	MAPISelectable                            = 0x3609 // PidTagSelectable
+	MAPISubfolders                            = 0x360A // PidTagSubfolders
+	MAPIStatus                                = 0x360B // PR_STATUS
+	MAPIAnr                                   = 0x360C // PidTagAnr
+	MAPIContentsSortOrder                     = 0x360D // PR_CONTENTS_SORT_ORDER
+	MAPIContainerHierarchy                    = 0x360E // PidTagContainerHierarchy
+	MAPIContainerContents                     = 0x360F // PidTagContainerContents
+	MAPIFolderAssociatedContents              = 0x3610 // PidTagFolderAssociatedContents
+	MAPIDefCreateDl                           = 0x3611 // PR_DEF_CREATE_DL
+	MAPIDefCreateMailuser                     = 0x3612 // PR_DEF_CREATE_MAILUSER
+	MAPIContainerClass                        = 0x3613 // PidTagContainerClass
+	MAPIContainerModifyVersion                = 0x3614 // PR_CONTAINER_MODIFY_VERSION
+	MAPIAbProviderID                          = 0x3615 // PR_AB_PROVIDER_ID
+	MAPIDefaultViewEntryID                    = 0x3616 // PR_DEFAULT_VIEW_ENTRYID
+	MAPIAssocContentCount                     = 0x3617 // PidTagAssociatedContentCount
+	MAPIAttachmentX400Parameters              = 0x3700 // PR_ATTACHMENT_X400_PARAMETERS
+	MAPIAttachDataBin                         = 0x3701 // PidTagAttachDataBinary
+	MAPIAttachDataObj                         = 0x3701 // PidTagAttachDataObject
+	MAPIAttachEncoding                        = 0x3702 // PidTagAttachEncoding
+	MAPIAttachExtension                       = 0x3703 // PidTagAttachExtension
+	MAPIAttachFilename                        = 0x3704 // PidTagAttachFilename
+	MAPIAttachMethod                          = 0x3705 // PidTagAttachMethod
+	MAPIAttachLongFilename                    = 0x3707 // PidTagAttachLongFilename
+	MAPIAttachPathname                        = 0x3708 // PidTagAttachPathname
+	MAPIAttachRendering                       = 0x3709 // PidTagAttachRendering
+	MAPIAttachTag                             = 0x370A // PidTagAttachTag
+	MAPIRenderingPosition                     = 0x370B // PidTagRenderingPosition
+	MAPIAttachTransportName                   = 0x370C // PidTagAttachTransportName
+	MAPIAttachLongPathname                    = 0x370D // PidTagAttachLongPathname
+	MAPIAttachMimeTag                         = 0x370E // PidTagAttachMimeTag
+	MAPIAttachAdditionalInfo                  = 0x370F // PidTagAttachAdditionalInformation
+	MAPIAttachContentBase                     = 0x3711 // PidTagAttachContentBase
+	MAPIAttachContentID                       = 0x3712 // PidTagAttachContentId
+	MAPIAttachContentLocation                 = 0x3713 // PidTagAttachContentLocation
+	MAPIAttachFlags                           = 0x3714 // PidTagAttachFlags
+	MAPITextAttachmentCharset                 = 0x371B // PidTagTextAttachmentCharset
+	MAPIDisplayType                           = 0x3900 // PidTagDisplayType
+	MAPITemplateID                            = 0x3902 // PidTagTemplateid
+	MAPIPrimaryCapability                     = 0x3904 // PR_PRIMARY_CAPABILITY
+	MAPIDisplayTypeEx                         = 0x3905 // PidTagDisplayTypeEx
+	MAPISmtpAddress                           = 0x39FE // PidTagSmtpAddress
+	MAPI7bitDisplayName                       = 0x39FF // PidTagAddressBookDisplayNamePrintable
+	MAPIAccount                               = 0x3A00 // PidTagAccount
+	MAPIAlternateRecipient                    = 0x3A01 // PR_ALTERNATE_RECIPIENT
+	MAPICallbackTelephoneNumber               = 0x3A02 // PidTagCallbackTelephoneNumber
+	MAPIConversionProhibited                  = 0x3A03 // PR_CONVERSION_PROHIBITED
+	MAPIDiscloseRecipients                    = 0x3A04 // PR_DISCLOSE_RECIPIENTS
+	MAPIGeneration                            = 0x3A05 // PidTagGeneration
+	MAPIGivenName                             = 0x3A06 // PidTagGivenName
+	MAPIGovernmentIDNumber                    = 0x3A07 // PidTagGovernmentIdNumber
+	MAPIBusinessTelephoneNumber               = 0x3A08 // PidTagBusinessTelephoneNumber
+	MAPIHomeTelephoneNumber                   = 0x3A09 // PidTagHomeTelephoneNumber
+	MAPIInitials                              = 0x3A0A // PidTagInitials
+	MAPIKeyword                               = 0x3A0B // PidTagKeyword
+	MAPILanguage                              = 0x3A0C // PidTagLanguage
+	MAPILocation                              = 0x3A0D // PidTagLocation
+	MAPIMailPermission                        = 0x3A0E // PR_MAIL_PERMISSION
+	MAPIMhsCommonName                         = 0x3A0F // PidTagMessageHandlingSystemCommonName
+	MAPIOrganizationalIDNumber                = 0x3A10 // PidTagOrganizationalIdNumber
+	MAPISurname                               = 0x3A11 // PidTagSurname
+	MAPIOriginalEntryID                       = 0x3A12 // PidTagOriginalEntryId
+	MAPIOriginalDisplayName                   = 0x3A13 // PR_ORIGINAL_DISPLAY_NAME
+	MAPIOriginalSearchKey                     = 0x3A14 // PR_ORIGINAL_SEARCH_KEY
+	MAPIPostalAddress                         = 0x3A15 // PidTagPostalAddress
+	MAPICompanyName                           = 0x3A16 // PidTagCompanyName
+	MAPITitle                                 = 0x3A17 // PidTagTitle
+	MAPIDepartmentName                        = 0x3A18 // PidTagDepartmentName
+	MAPIOfficeLocation                        = 0x3A19 // PidTagOfficeLocation
+	MAPIPrimaryTelephoneNumber                = 0x3A1A // PidTagPrimaryTelephoneNumber
+	MAPIBusiness2TelephoneNumber              = 0x3A1B // PidTagBusiness2TelephoneNumber
+	MAPIMobileTelephoneNumber                 = 0x3A1C // PidTagMobileTelephoneNumber
+	MAPIRadioTelephoneNumber                  = 0x3A1D // PidTagRadioTelephoneNumber
+	MAPICarTelephoneNumber                    = 0x3A1E // PidTagCarTelephoneNumber
+	MAPIOtherTelephoneNumber                  = 0x3A1F // PidTagOtherTelephoneNumber
+	MAPITransmitableDisplayName               = 0x3A20 // PidTagTransmittableDisplayName
+	MAPIPagerTelephoneNumber                  = 0x3A21 // PidTagPagerTelephoneNumber
+	MAPIUserCertificate                       = 0x3A22 // PidTagUserCertificate
+	MAPIPrimaryFaxNumber                      = 0x3A23 // PidTagPrimaryFaxNumber
+	MAPIBusinessFaxNumber                     = 0x3A24 // PidTagBusinessFaxNumber
+	MAPIHomeFaxNumber                         = 0x3A25 // PidTagHomeFaxNumber
+	MAPICountry                               = 0x3A26 // PidTagCountry
+	MAPILocality                              = 0x3A27 // PidTagLocality
+	MAPIStateOrProvince                       = 0x3A28 // PidTagStateOrProvince
+	MAPIStreetAddress                         = 0x3A29 // PidTagStreetAddress
+	MAPIPostalCode                            = 0x3A2A // PidTagPostalCode
+	MAPIPostOfficeBox                         = 0x3A2B // PidTagPostOfficeBox
+	MAPITelexNumber                           = 0x3A2C // PidTagTelexNumber
+	MAPIIsdnNumber                            = 0x3A2D // PidTagIsdnNumber
+	MAPIAssistantTelephoneNumber              = 0x3A2E // PidTagAssistantTelephoneNumber
+	MAPIHome2TelephoneNumber                  = 0x3A2F // PidTagHome2TelephoneNumber
+	MAPIAssistant                             = 0x3A30 // PidTagAssistant
+	MAPISendRichInfo                          = 0x3A40 // PidTagSendRichInfo
+	MAPIWeddingAnniversary                    = 0x3A41 // PidTagWeddingAnniversary
+	MAPIBirthday                              = 0x3A42 // PidTagBirthday
+	MAPIHobbies                               = 0x3A43 // PidTagHobbies
+	MAPIMiddleName                            = 0x3A44 // PidTagMiddleName
+	MAPIDisplayNamePrefix                     = 0x3A45 // PidTagDisplayNamePrefix
+	MAPIProfession                            = 0x3A46 // PidTagProfession
+	MAPIPreferredByName                       = 0x3A47 // PidTagReferredByName
+	MAPISpouseName                            = 0x3A48 // PidTagSpouseName
+	MAPIComputerNetworkName                   = 0x3A49 // PidTagComputerNetworkName
+	MAPICustomerID                            = 0x3A4A // PidTagCustomerId
+	MAPITtytddPhoneNumber                     = 0x3A4B // PidTagTelecommunicationsDeviceForDeafTelephoneNumber
+	MAPIFtpSite                               = 0x3A4C // PidTagFtpSite
+	MAPIGender                                = 0x3A4D // PidTagGender
+	MAPIManagerName                           = 0x3A4E // PidTagManagerName
+	MAPINickname                              = 0x3A4F // PidTagNickname
+	MAPIPersonalHomePage                      = 0x3A50 // PidTagPersonalHomePage
+	MAPIBusinessHomePage                      = 0x3A51 // PidTagBusinessHomePage
+	MAPIContactVersion                        = 0x3A52 // PR_CONTACT_VERSION
+	MAPIContactEntryids                       = 0x3A53 // PR_CONTACT_ENTRYIDS
+	MAPIContactAddrtypes                      = 0x3A54 // PR_CONTACT_ADDRTYPES
+	MAPIContactDefaultAddressIndex            = 0x3A55 // PR_CONTACT_DEFAULT_ADDRESS_INDEX
+	MAPIContactEmailAddresses                 = 0x3A56 // PR_CONTACT_EMAIL_ADDRESSES
+	MAPICompanyMainPhoneNumber                = 0x3A57 // PidTagCompanyMainTelephoneNumber
+	MAPIChildrensNames                        = 0x3A58 // PidTagChildrensNames
+	MAPIHomeAddressCity                       = 0x3A59 // PidTagHomeAddressCity
+	MAPIHomeAddressCountry                    = 0x3A5A // PidTagHomeAddressCountry
+	MAPIHomeAddressPostalCode                 = 0x3A5B // PidTagHomeAddressPostalCode
+	MAPIHomeAddressStateOrProvince            = 0x3A5C // PidTagHomeAddressStateOrProvince
+	MAPIHomeAddressStreet                     = 0x3A5D // PidTagHomeAddressStreet
+	MAPIHomeAddressPostOfficeBox              = 0x3A5E // PidTagHomeAddressPostOfficeBox
+	MAPIOtherAddressCity                      = 0x3A5F // PidTagOtherAddressCity
+	MAPIOtherAddressCountry                   = 0x3A60 // PidTagOtherAddressCountry
+	MAPIOtherAddressPostalCode                = 0x3A61 // PidTagOtherAddressPostalCode
+	MAPIOtherAddressStateOrProvince           = 0x3A62 // PidTagOtherAddressStateOrProvince
+	MAPIOtherAddressStreet                    = 0x3A63 // PidTagOtherAddressStreet
+	MAPIOtherAddressPostOfficeBox             = 0x3A64 // PidTagOtherAddressPostOfficeBox
+	MAPIUserX509Certificate                   = 0x3A70 // PidTagUserX509Certificate
+	MAPISendInternetEncoding                  = 0x3A71 // PidTagSendInternetEncoding
+	MAPIStoreProviders                        = 0x3D00 // PR_STORE_PROVIDERS
+	MAPIAbProviders                           = 0x3D01 // PR_AB_PROVIDERS
+	MAPITransportProviders                    = 0x3D02 // PR_TRANSPORT_PROVIDERS
+	MAPIDefaultProfile                        = 0x3D04 // PR_DEFAULT_PROFILE
+	MAPIAbSearchPath                          = 0x3D05 // PR_AB_SEARCH_PATH
+	MAPIAbDefaultDir                          = 0x3D06 // PR_AB_DEFAULT_DIR
+	MAPIAbDefaultPab                          = 0x3D07 // PR_AB_DEFAULT_PAB
+	MAPIFilteringHooks                        = 0x3D08 // PR_FILTERING_HOOKS
+	MAPIServiceName                           = 0x3D09 // PR_SERVICE_NAME
+	MAPIServiceDllName                        = 0x3D0A // PR_SERVICE_DLL_NAME
+	MAPIServiceEntryName                      = 0x3D0B // PR_SERVICE_ENTRY_NAME
+	MAPIServiceUID                            = 0x3D0C // PR_SERVICE_UID
+	MAPIServiceExtraUids                      = 0x3D0D // PR_SERVICE_EXTRA_UIDS
+	MAPIServices                              = 0x3D0E // PR_SERVICES
+	MAPIServiceSupportFiles                   = 0x3D0F // PR_SERVICE_SUPPORT_FILES
+	MAPIServiceDeleteFiles                    = 0x3D10 // PR_SERVICE_DELETE_FILES
+	MAPIAbSearchPathUpdate                    = 0x3D11 // PR_AB_SEARCH_PATH_UPDATE
+	MAPIProfileName                           = 0x3D12 // PR_PROFILE_NAME
+	MAPIIdentityDisplay                       = 0x3E00 // PR_IDENTITY_DISPLAY
+	MAPIIdentityEntryID                       = 0x3E01 // PR_IDENTITY_ENTRYID
+	MAPIResourceMethods                       = 0x3E02 // PR_RESOURCE_METHODS
+	MAPIResourceType                          = 0x3E03 // PR_RESOURCE_TYPE
+	MAPIStatusCode                            = 0x3E04 // PR_STATUS_CODE
+	MAPIIdentitySearchKey                     = 0x3E05 // PR_IDENTITY_SEARCH_KEY
+	MAPIOwnStoreEntryID                       = 0x3E06 // PR_OWN_STORE_ENTRYID
+	MAPIResourcePath                          = 0x3E07 // PR_RESOURCE_PATH
+	MAPIStatusString                          = 0x3E08 // PR_STATUS_STRING
+	MAPIX400DeferredDeliveryCancel            = 0x3E09 // PR_X400_DEFERRED_DELIVERY_CANCEL
+	MAPIHeaderFolderEntryID                   = 0x3E0A // PR_HEADER_FOLDER_ENTRYID
+	MAPIRemoteProgress                        = 0x3E0B // PR_REMOTE_PROGRESS
+	MAPIRemoteProgressText                    = 0x3E0C // PR_REMOTE_PROGRESS_TEXT
+	MAPIRemoteValidateOk                      = 0x3E0D // PR_REMOTE_VALIDATE_OK
+	MAPIControlFlags                          = 0x3F00 // PR_CONTROL_FLAGS
+	MAPIControlStructure                      = 0x3F01 // PR_CONTROL_STRUCTURE
+	MAPIControlType                           = 0x3F02 // PR_CONTROL_TYPE
+	MAPIDeltax                                = 0x3F03 // PR_DELTAX
+	MAPIDeltay                                = 0x3F04 // PR_DELTAY
+	MAPIXpos                                  = 0x3F05 // PR_XPOS
+	MAPIYpos                                  = 0x3F06 // PR_YPOS
+	MAPIControlID                             = 0x3F07 // PR_CONTROL_ID
+	MAPIInitialDetailsPane                    = 0x3F08 // PR_INITIAL_DETAILS_PANE
+	MAPIInternetCPID                          = 0x3FDE // PidTagInternetCodepage
+	MAPIAutoResponseSuppress                  = 0x3FDF // PidTagAutoResponseSuppress
+	MAPIDelegatedByRule                       = 0x3FE3 // PidTagDelegatedByRule
+	MAPIMessageLocaleID                       = 0x3FF1 // PidTagMessageLocaleId
+	MAPICreatorName                           = 0x3FF8 // PidTagCreatorName
+	MAPICreatorEntryID                        = 0x3FF9 // PidTagCreatorEntryId
+	MAPILastModifierName                      = 0x3FFA // PidTagLastModifierName
+	MAPILastModifierEntryID                   = 0x3FFB // PidTagLastModifierEntryId
+	MAPIMessageCodepage                       = 0x3FFD // PidTagMessageCodepage
+	MAPIContentFilterSCL                      = 0x4076 // PidTagContentFilterSpamConfidenceLevel
+	MAPIMsgEditorFormat                       = 0x5909 // PidTagMessageEditorFormat
+	MAPISenderSmtpAddress                     = 0x5D01 // PidTagSenderSmtpAddress
+	MAPISentRepresentingSmtpAddress           = 0x5D02 // PidTagSentRepresentingSmtpAddress
+	MAPIReadReceiptSmtpAddress                = 0x5D05 // PidTagReadReceiptSmtpAddress
+	MAPIReceivedBySmtpAddress                 = 0x5D07 // PidTagReceivedBySmtpAddress
+	MAPIRcvdRepresentingSmtpAddress           = 0x5D08 // PidTagReceivedRepresentingSmtpAddress
+	MAPIRecipientOrder                        = 0x5FDF // PidTagRecipientOrder
+	MAPIRecipientDisplayName                  = 0x5FF6 // PidTagRecipientDisplayName
+	MAPIRecipientEntryID                      = 0x5FF7 // PidTagRecipientEntryId
+	MAPIRecipientTrackStatusTime              = 0x5FFB // PidTagRecipientTrackStatusTime
+	MAPIRecipientFlags                        = 0x5FFD // PidTagRecipientFlags
+	MAPIRecipientTrackStatus                  = 0x5FFF // PidTagRecipientTrackStatus
+	MAPISourceKey                             = 0x65E0 // PidTagSourceKey
+	MAPIParentSourceKey                       = 0x65E1 // PidTagParentSourceKey
+	MAPIChangeKey                             = 0x65E2 // PidTagChangeKey
+	MAPIPredecessorChangeList                 = 0x65E3 // PidTagPredecessorChangeList
+	MAPILocaleID                              = 0x66A1 // PidTagLocaleId
+	MAPIExceptionReplaceTime                  = 0x7FF9 // PidTagExceptionReplaceTime
+	MAPIAttachmentLinkID                      = 0x7FFA // PidTagAttachmentLinkId
+	MAPIExceptionStartTime                    = 0x7FFB // PidTagExceptionStartTime
+	MAPIExceptionEndTime                      = 0x7FFC // PidTagExceptionEndTime
+	MAPIAttachmentFlags                       = 0x7FFD // PidTagAttachmentFlags
+	MAPIAttachmentHidden                      = 0x7FFE // PidTagAttachmentHidden
+	MAPIAttachmentContactPhoto                = 0x7FFF // PidTagAttachmentContactPhoto
+	MAPIIdSecureMin                           = 0x67F0
+	MAPIIdSecureMax                           = 0x67FF
+)
@@ -0,0 +1,281 @@
+package tnef
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// ErrPropertyType signals that a MAPIAttribute accessor was used on a property
+// of a different type, e.g. Int on a string property.
+var ErrPropertyType = errors.New("wrong property type")
+
+// GUID is a Microsoft GUID, in the mixed-endian format used by MAPI.
+type GUID [16]byte
+
+// String formats the GUID as {00062002-0000-0000-C000-000000000046}.
+func (g GUID) String() string {
+	return fmt.Sprintf("{%08X-%04X-%04X-%X-%X}",
+		binary.LittleEndian.Uint32(g[0:4]), binary.LittleEndian.Uint16(g[4:6]),
+		binary.LittleEndian.Uint16(g[6:8]), g[8:10], g[10:16])
+}
+
+// Property sets of named properties.
+var (
+	PSETIDAppointment = mustParseGUID("00062002-0000-0000-C000-000000000046")
+	PSETIDTask        = mustParseGUID("00062003-0000-0000-C000-000000000046")
+	PSETIDAddress     = mustParseGUID("00062004-0000-0000-C000-000000000046")
+	PSETIDCommon      = mustParseGUID("00062008-0000-0000-C000-000000000046")
+	PSETIDLog         = mustParseGUID("0006200A-0000-0000-C000-000000000046")
+	PSETIDNote        = mustParseGUID("0006200E-0000-0000-C000-000000000046")
+	PSETIDMeeting     = mustParseGUID("6ED8DA90-450B-101B-98DA-00AA003F1305")
+	PSInternetHeaders = mustParseGUID("00020386-0000-0000-C000-000000000046")
+	PSPublicStrings   = mustParseGUID("00020329-0000-0000-C000-000000000046")
+)
+
+// mustParseGUID parses a GUID in the format used by String, without the
+// braces.
+func mustParseGUID(s string) GUID {
+	var g GUID
+	b, err := hex.DecodeString(strings.Replace(s, "-", "", -1))
+	if err != nil || len(b) != 16 {
+		panic("tnef: invalid GUID " + s)
+	}
+
+	// The first three groups are little-endian.
+	binary.LittleEndian.PutUint32(g[0:4], binary.BigEndian.Uint32(b[0:4]))
+	binary.LittleEndian.PutUint16(g[4:6], binary.BigEndian.Uint16(b[4:6]))
+	binary.LittleEndian.PutUint16(g[6:8], binary.BigEndian.Uint16(b[6:8]))
+	copy(g[8:], b[8:])
+	return g
+}
+
+// FILETIME is the number of 100-nanosecond intervals since 1601-01-01, which is
+// this many seconds before the Unix epoch.
+const filetimeUnixOffset = 11644473600
+
+// OLE automation dates are the number of days since 1899-12-30.
+var apptimeEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+func (a MAPIAttribute) typeError(want string) error {
+	if a.IsMultiValue {
+		return fmt.Errorf("%w: property %s is multi-valued", ErrPropertyType, a.PropName())
+	}
+	return fmt.Errorf("%w: property %s has type 0x%04x, not %s", ErrPropertyType, a.PropName(), a.Type, want)
+}
+
+// value gets the first n bytes of the data, or an error if the property is not
+// one of the types.
+func (a MAPIAttribute) value(n int, want string, types ...int) ([]byte, error) {
+	if a.IsMultiValue {
+		return nil, a.typeError(want)
+	}
+	for _, t := range types {
+		if a.Type != t {
+			continue
+		}
+		if len(a.Data) < n {
+			return nil, fmt.Errorf("%w: property %s has %d bytes of data, need %d",
+				ErrPropertyType, a.PropName(), len(a.Data), n)
+		}
+		return a.Data[:n], nil
+	}
+	return nil, a.typeError(want)
+}
+
+// Int gets the value of a 16-bit or 32-bit integer property, or an error
+// property (which is an unsigned 32-bit integer).
+func (a MAPIAttribute) Int() (int, error) {
+	switch a.Type {
+	case szmapiShort:
+		d, err := a.value(2, "integer", szmapiShort)
+		if err != nil {
+			return 0, err
+		}
+		return int(int16(binary.LittleEndian.Uint16(d))), nil
+	case szmapiError:
+		d, err := a.value(4, "integer", szmapiError)
+		if err != nil {
+			return 0, err
+		}
+		return int(binary.LittleEndian.Uint32(d)), nil
+	default:
+		d, err := a.value(4, "integer", szmapiInt)
+		if err != nil {
+			return 0, err
+		}
+		return int(int32(binary.LittleEndian.Uint32(d))), nil
+	}
+}
+
+// Int64 gets the value of a 64-bit integer or currency property, or of a
+// 16-bit or 32-bit integer property.
+//
+// Currency values are returned as-is; they're scaled by 10,000.
+func (a MAPIAttribute) Int64() (int64, error) {
+	switch a.Type {
+	case szmapiShort, szmapiInt, szmapiError:
+		i, err := a.Int()
+		return int64(i), err
+	}
+
+	d, err := a.value(8, "64-bit integer", szmapiInt8byte, szmapiCurrency)
+	if err != nil {
+		return 0, err
+	}
+	return int64(binary.LittleEndian.Uint64(d)), nil
+}
+
+// Bool gets the value of a boolean property.
+func (a MAPIAttribute) Bool() (bool, error) {
+	d, err := a.value(2, "boolean", szmapiBoolean)
+	if err != nil {
+		return false, err
+	}
+	return d[0] != 0 || d[1] != 0, nil
+}
+
+// Float gets the value of a floating point property. For application time
+// properties this is the number of days since 1899-12-30; use Time to get it
+// as a time.Time.
+func (a MAPIAttribute) Float() (float64, error) {
+	if a.Type == szmapiFloat {
+		d, err := a.value(4, "float", szmapiFloat)
+		if err != nil {
+			return 0, err
+		}
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(d))), nil
+	}
+
+	d, err := a.value(8, "float", szmapiDouble, szmapiApptime)
+	if err != nil {
+		return 0, err
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(d)), nil
+}
+
+// Time gets the value of a time (FILETIME) or application time (OLE
+// automation date) property, in UTC.
+func (a MAPIAttribute) Time() (time.Time, error) {
+	if a.Type == szmapiApptime {
+		days, err := a.Float()
+		if err != nil {
+			return time.Time{}, err
+		}
+		return apptimeEpoch.Add(time.Duration(days * float64(24*time.Hour))), nil
+	}
+
+	d, err := a.value(8, "time", szmapiSystime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return filetimeToTime(binary.LittleEndian.Uint64(d)), nil
+}
+
+func filetimeToTime(ft uint64) time.Time {
+	// time.Duration can only hold about 290 years, so convert via Unix time.
+	const perSecond = uint64(time.Second / 100)
+	return time.Unix(int64(ft/perSecond)-filetimeUnixOffset, int64(ft%perSecond)*100).UTC()
+}
+
+// StringValue gets the value of a string property. Unicode strings are
+// converted from UTF-16 to UTF-8, and 8-bit strings from the code page of the
+// message (see Data.Codepage). Any trailing NUL bytes are removed.
+func (a MAPIAttribute) StringValue() (string, error) {
+	if a.IsMultiValue {
+		return "", a.typeError("string")
+	}
+	switch a.Type {
+	case szmapiUnicodeString:
+		return strings.TrimRight(decodeUTF16(a.Data), "\x00"), nil
+	case szmapiString:
+		return decodeCodepage(a.codepage, bytes.TrimRight(a.Data, "\x00")), nil
+	}
+	return "", a.typeError("string")
+}
+
+func decodeUTF16(b []byte) string {
+	u := make([]uint16, len(b)/2)
+	for i := range u {
+		u[i] = binary.LittleEndian.Uint16(b[i*2:])
+	}
+	return string(utf16.Decode(u))
+}
+
+// CLSID gets the value of a GUID (CLSID) property.
+func (a MAPIAttribute) CLSID() (GUID, error) {
+	var g GUID
+	d, err := a.value(16, "GUID", szmapiCLSID)
+	if err != nil {
+		return g, err
+	}
+	copy(g[:], d)
+	return g, nil
+}
+
+// Binary gets the value of a binary or object property.
+func (a MAPIAttribute) Binary() ([]byte, error) {
+	if a.IsMultiValue {
+		return nil, a.typeError("binary")
+	}
+	switch a.Type {
+	case szmapiBinary, szmapiObject:
+		return a.Data, nil
+	}
+	return nil, a.typeError("binary")
+}
+
+// values gets the values of the property, or an error if it's not one of the
+// types. Properties that are not multi-valued are returned as one value.
+func (a MAPIAttribute) values(want string, types ...int) ([][]byte, error) {
+	for _, t := range types {
+		if a.Type != t {
+			continue
+		}
+		if a.Values == nil {
+			return [][]byte{a.Data}, nil
+		}
+		return a.Values, nil
+	}
+	return nil, fmt.Errorf("%w: property %s has type 0x%04x, not %s", ErrPropertyType, a.PropName(), a.Type, want)
+}
+
+// Strings gets the values of a (multi-valued) string property; see String.
+func (a MAPIAttribute) Strings() ([]string, error) {
+	values, err := a.values("string", szmapiString, szmapiUnicodeString)
+	if err != nil {
+		return nil, err
+	}
+
+	s := make([]string, 0, len(values))
+	for _, v := range values {
+		str, _ := MAPIAttribute{Type: a.Type, Data: v, codepage: a.codepage}.StringValue()
+		s = append(s, str)
+	}
+	return s, nil
+}
+
+// Int32s gets the values of a (multi-valued) 16-bit or 32-bit integer
+// property.
+func (a MAPIAttribute) Int32s() ([]int32, error) {
+	values, err := a.values("integer", szmapiShort, szmapiInt)
+	if err != nil {
+		return nil, err
+	}
+
+	ints := make([]int32, 0, len(values))
+	for _, v := range values {
+		i, err := MAPIAttribute{Type: a.Type, Name: a.Name, Data: v}.Int()
+		if err != nil {
+			return nil, err
+		}
+		ints = append(ints, int32(i))
+	}
+	return ints, nil
+}
@@ -0,0 +1,303 @@
+package tnef
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/teamwork/test"
+)
+
+func TestMAPIAttributeValues(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			in   MAPIAttribute
+			want int
+		}{
+			{MAPIAttribute{Type: szmapiShort, Data: []byte{0xfe, 0xff, 0, 0}}, -2},
+			{MAPIAttribute{Type: szmapiInt, Data: []byte{0xfe, 0xff, 0xff, 0xff}}, -2},
+			{MAPIAttribute{Type: szmapiError, Data: []byte{0x0e, 0x01, 0x04, 0x80}}, 0x8004010e},
+		}
+		for _, tt := range tests {
+			got, err := tt.in.Int()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		}
+	})
+
+	t.Run("int64", func(t *testing.T) {
+		got, err := MAPIAttribute{Type: szmapiCurrency, Data: []byte{0x10, 0x27, 0, 0, 0, 0, 0, 0}}.Int64()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != 10000 {
+			t.Errorf("got %d", got)
+		}
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		got, err := MAPIAttribute{Type: szmapiBoolean, Data: []byte{1, 0, 0, 0}}.Bool()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got {
+			t.Error("got false")
+		}
+	})
+
+	t.Run("float", func(t *testing.T) {
+		got, err := MAPIAttribute{Type: szmapiDouble, Data: []byte{0, 0, 0, 0, 0, 0, 0xf8, 0x3f}}.Float()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != 1.5 {
+			t.Errorf("got %v", got)
+		}
+	})
+
+	t.Run("time", func(t *testing.T) {
+		want := time.Date(2014, 6, 19, 12, 30, 0, 0, time.UTC)
+
+		// 2014-06-19 12:30 UTC as a FILETIME.
+		got, err := MAPIAttribute{Type: szmapiSystime,
+			Data: []byte{0x00, 0x94, 0xd9, 0x2f, 0xba, 0x8b, 0xcf, 0x01}}.Time()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+
+		// 41809.520833… days since 1899-12-30.
+		got, err = MAPIAttribute{Type: szmapiApptime,
+			Data: le64f(41809 + 12.5/24)}.Time()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		for _, in := range []MAPIAttribute{
+			{Type: szmapiString, Data: []byte("héllo\x00\x00")},
+			{Type: szmapiUnicodeString, Data: encodeUnicode("héllo")},
+		} {
+			got, err := in.StringValue()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != "héllo" {
+				t.Errorf("got %q", got)
+			}
+		}
+	})
+
+	t.Run("guid", func(t *testing.T) {
+		got, err := MAPIAttribute{Type: szmapiCLSID, Data: iidIMessage}.CLSID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "{00020307-0000-0000-C000-000000000046}"; got.String() != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		attr := MAPIAttribute{Type: szmapiUnicodeString, Name: MAPIAttachLongFilename, Data: encodeUnicode("a")}
+		if _, err := attr.Int(); !errors.Is(err, ErrPropertyType) {
+			t.Errorf("Int: wrong error: %v", err)
+		}
+		if _, err := attr.Time(); !errors.Is(err, ErrPropertyType) {
+			t.Errorf("Time: wrong error: %v", err)
+		}
+		if _, err := attr.Binary(); !errors.Is(err, ErrPropertyType) {
+			t.Errorf("Binary: wrong error: %v", err)
+		}
+
+		short := MAPIAttribute{Type: szmapiInt, Data: []byte{1}}
+		if _, err := short.Int(); !errors.Is(err, ErrPropertyType) {
+			t.Errorf("short data: wrong error: %v", err)
+		}
+	})
+
+	t.Run("decoded", func(t *testing.T) {
+		d, err := Decode(test.Read(t, "./testdata", "unicode-mapi-attr-name.tnef"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, attr := range d.Attachments[1].Attributes {
+			if attr.Name != MAPIAttachLongFilename {
+				continue
+			}
+			got, err := attr.StringValue()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != "image001.png" {
+				t.Errorf("got %q", got)
+			}
+		}
+	})
+}
+
+func le64f(f float64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, math.Float64bits(f))
+	return b
+}
+
+func TestMAPIAttributeMultiValue(t *testing.T) {
+	find := func(t *testing.T, file string, name int) MAPIAttribute {
+		d, err := Decode(test.Read(t, "./testdata", file+".tnef"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, attr := range d.Attributes {
+			if attr.Name == name {
+				return attr
+			}
+		}
+		t.Fatalf("no attribute 0x%04x", name)
+		return MAPIAttribute{}
+	}
+
+	t.Run("strings", func(t *testing.T) {
+		attr := find(t, "multi-name-property", 0x8075)
+		if !attr.IsMultiValue {
+			t.Error("IsMultiValue not set")
+		}
+		got, err := attr.Strings()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, []string{"Feiertag"}) {
+			t.Errorf("wrong value: %q", got)
+		}
+		if _, err := attr.StringValue(); !errors.Is(err, ErrPropertyType) {
+			t.Errorf("String: wrong error: %v", err)
+		}
+	})
+
+	t.Run("int32s", func(t *testing.T) {
+		attr := find(t, "multi-value-attribute", 0x1205)
+		got, err := attr.Int32s()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 1 {
+			t.Errorf("wrong value: %v", got)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		want := []MAPIAttribute{
+			{
+				Type:         szmapiUnicodeString,
+				Name:         0x6800,
+				Data:         append(encodeUnicode("one"), encodeUnicode("two")...),
+				Values:       [][]byte{encodeUnicode("one"), encodeUnicode("two")},
+				IsMultiValue: true,
+			},
+			{
+				Type:         szmapiInt,
+				Name:         0x6801,
+				Data:         []byte{1, 0, 0, 0, 2, 0, 0, 0},
+				Values:       [][]byte{{1, 0, 0, 0}, {2, 0, 0, 0}},
+				IsMultiValue: true,
+			},
+		}
+		got, err := decodeMapi(encodeMapi(want), 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("\ngot:  %#v\nwant: %#v", got, want)
+		}
+
+		strs, err := got[0].Strings()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(strs, []string{"one", "two"}) {
+			t.Errorf("wrong strings: %q", strs)
+		}
+		ints, err := got[1].Int32s()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(ints, []int32{1, 2}) {
+			t.Errorf("wrong ints: %v", ints)
+		}
+	})
+}
+
+func TestNamedProperties(t *testing.T) {
+	tests := []struct {
+		in          string
+		propSet     GUID
+		namedID     int32
+		namedString string
+		want        string
+	}{
+		{"multi-name-property", PSPublicStrings, 0, "Keywords", "Feiertag"},
+		{"multi-name-property", PSETIDAppointment, 0x8208, "", "Deutschland"},
+		{"unicode-mapi-attr-name", PSInternetHeaders, 0, "x-originating-ip", "[10.34.7.107]"},
+		{"unicode-mapi-attr-name", PSETIDCommon, 0x85d8, "", "IPM.Note"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in+"/"+tt.namedString, func(t *testing.T) {
+			d, err := Decode(test.Read(t, "./testdata", tt.in+".tnef"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, attr := range d.Attributes {
+				if attr.PropSet != tt.propSet || attr.NamedID != tt.namedID || attr.NamedString != tt.namedString {
+					continue
+				}
+				if attr.Name < 0x8000 {
+					t.Errorf("wrong name: 0x%04x", attr.Name)
+				}
+
+				var got string
+				if attr.IsMultiValue {
+					s, err := attr.Strings()
+					if err != nil {
+						t.Fatal(err)
+					}
+					got = s[0]
+				} else {
+					got, err = attr.StringValue()
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+				if got != tt.want {
+					t.Errorf("got %q, want %q", got, tt.want)
+				}
+				return
+			}
+			t.Errorf("property not found")
+		})
+	}
+}
+
+func TestGUID(t *testing.T) {
+	want := GUID{0x90, 0xda, 0xd8, 0x6e, 0x0b, 0x45, 0x1b, 0x10, 0x98, 0xda, 0x00, 0xaa, 0x00, 0x3f, 0x13, 0x05}
+	if PSETIDMeeting != want {
+		t.Errorf("wrong bytes: %x", PSETIDMeeting[:])
+	}
+	if got := PSETIDMeeting.String(); got != "{6ED8DA90-450B-101B-98DA-00AA003F1305}" {
+		t.Errorf("wrong string: %s", got)
+	}
+}
@@ -0,0 +1,209 @@
+package tnef
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// Address is the name and address of a sender or recipient.
+type Address struct {
+	Name        string // Display name.
+	AddressType string // Type of the address, usually "SMTP" or "EX" (Exchange).
+	Email       string
+}
+
+// Message priorities, as stored in Data.Priority.
+const (
+	PriorityUnknown = 0
+	PriorityHigh    = 1
+	PriorityNormal  = 2
+	PriorityLow     = 3
+)
+
+// addAttr adds the legacy (non-MAPI) message attributes in obj to the typed
+// fields. They're only used if the MAPI properties don't contain the same
+// information; see mapiFields.
+//
+// The strings are kept and decoded by legacyFields, as the code page may only
+// be known after all objects are read.
+func (t *Data) addAttr(obj tnefObject) error {
+	buf := &buffer{data: obj.Data, base: obj.Offset + objectHeaderSize, attr: obj.Name}
+
+	var err error
+	switch obj.Name {
+	case ATTBODY:
+		if len(t.Body) == 0 {
+			t.Body = obj.Data
+		}
+	case ATTPRIORITY:
+		t.Priority, err = buf.int(2)
+	case ATTTNEFVERSION:
+		t.TNEFVersion, err = buf.int(4)
+	case ATTDATESENT:
+		t.SentAt, err = decodeDTR(buf)
+	case ATTDATERECD:
+		t.ReceivedAt, err = decodeDTR(buf)
+	case ATTOEMCODEPAGE:
+		// The primary code page, followed by a secondary one which isn't
+		// used.
+		t.Codepage, err = buf.int(4)
+	default:
+		// Keep the other attributes for the views such as Appointment.
+		t.legacy = append(t.legacy, obj)
+	}
+	return err
+}
+
+// legacyFields decodes the legacy string attributes kept by addAttr in to the
+// typed fields, with the code page of the message.
+func (t *Data) legacyFields() error {
+	for _, obj := range t.legacy {
+		var err error
+		switch obj.Name {
+		case ATTSUBJECT:
+			t.Subject = legacyString(t.Codepage, obj.Data)
+		case ATTMESSAGECLASS:
+			t.MessageClass = legacyString(t.Codepage, obj.Data)
+		case ATTMESSAGEID:
+			t.MessageID = legacyString(t.Codepage, obj.Data)
+		case ATTFROM:
+			buf := &buffer{data: obj.Data, base: obj.Offset + objectHeaderSize, attr: obj.Name}
+			t.From, err = decodeTriple(buf, t.Codepage)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// legacyAttr gets a buffer to read the data of the legacy attribute with the
+// name and type, or nil if it's not present.
+//
+// Different attributes can have the same name but a different type, e.g.
+// ATTDATESTART and the original message class.
+func (t *Data) legacyAttr(name, typ int) *buffer {
+	for _, obj := range t.legacy {
+		if obj.Name == name && obj.Type == typ {
+			return &buffer{data: obj.Data, base: obj.Offset + objectHeaderSize, attr: obj.Name}
+		}
+	}
+	return nil
+}
+
+// legacyString gets the value of a legacy string attribute, which is
+// NUL-terminated and in the code page cp.
+func legacyString(cp int, data []byte) string {
+	return decodeCodepage(cp, bytes.TrimRight(data, "\x00"))
+}
+
+// decodeDTR decodes a date in the TNEF DTR format: year, month, day, hour,
+// minute, second, and day of the week as 16-bit integers.
+//
+// The time zone isn't stored, and Outlook writes the sender's local time. The
+// offset can't be known, so the date is returned with the time.UTC location;
+// prefer the MAPI properties, which are in UTC, if they're available.
+func decodeDTR(buf *buffer) (time.Time, error) {
+	var f [6]int
+	for i := range f {
+		var err error
+		if f[i], err = buf.int(2); err != nil {
+			return time.Time{}, err
+		}
+	}
+	// The day of the week is redundant.
+	return time.Date(f[0], time.Month(f[1]), f[2], f[3], f[4], f[5], 0, time.UTC), nil
+}
+
+// decodeTriple decodes the "triple" used for the sender in ATTFROM: a header
+// with the lengths, followed by the display name and the address as
+// "TYPE:address". The strings are in the code page cp.
+func decodeTriple(buf *buffer, cp int) (Address, error) {
+	var addr Address
+	// ID (always 0x0004) and total length of the triple.
+	if err := buf.skip(4); err != nil {
+		return addr, err
+	}
+	nameLen, err := buf.int(2)
+	if err != nil {
+		return addr, err
+	}
+	addrLen, err := buf.int(2)
+	if err != nil {
+		return addr, err
+	}
+
+	name, err := buf.bytes(nameLen)
+	if err != nil {
+		return addr, err
+	}
+	email, err := buf.bytes(addrLen)
+	if err != nil {
+		return addr, err
+	}
+
+	addr.Name = legacyString(cp, name)
+	addr.Email = legacyString(cp, email)
+	addr.AddressType, addr.Email = splitAddress(addr.Email)
+	return addr, nil
+}
+
+// splitAddress splits an address in the form "TYPE:address", as used in
+// legacy attributes.
+func splitAddress(s string) (addrType, email string) {
+	if i := strings.IndexByte(s, ':'); i > 0 {
+		return s[:i], s[i+1:]
+	}
+	return "", s
+}
+
+// mapiFields sets the typed fields from the MAPI properties, overriding the
+// values from the legacy attributes.
+func (t *Data) mapiFields() {
+	var from, sender Address
+	for _, attr := range t.Attributes {
+		switch attr.Name {
+		case MAPISubject:
+			t.Subject, _ = attr.StringValue()
+		case MAPIMessageClass:
+			t.MessageClass, _ = attr.StringValue()
+		case MAPISearchKey:
+			if b, err := attr.Binary(); err == nil {
+				t.MessageID = strings.ToUpper(hex.EncodeToString(b))
+			}
+		case MAPIImportance:
+			// 0 is low, 1 normal, and 2 high.
+			if i, err := attr.Int(); err == nil && i >= 0 && i <= 2 {
+				t.Priority = PriorityLow - i
+			}
+		case MAPIClientSubmitTime:
+			t.SentAt, _ = attr.Time()
+		case MAPIMessageDeliveryTime:
+			t.ReceivedAt, _ = attr.Time()
+
+		case MAPISentRepresentingName:
+			from.Name, _ = attr.StringValue()
+		case MAPISentRepresentingAddrtype:
+			from.AddressType, _ = attr.StringValue()
+		case MAPISentRepresentingEmailAddress:
+			from.Email, _ = attr.StringValue()
+		case MAPISenderName:
+			sender.Name, _ = attr.StringValue()
+		case MAPISenderAddrtype:
+			sender.AddressType, _ = attr.StringValue()
+		case MAPISenderEmailAddress:
+			sender.Email, _ = attr.StringValue()
+		}
+	}
+
+	// The message is from the "sent representing" user; the sender is only
+	// different if someone sent it on their behalf.
+	switch {
+	case from != Address{}:
+		t.From = from
+	case sender != Address{}:
+		t.From = sender
+	}
+}
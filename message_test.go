@@ -0,0 +1,186 @@
+package tnef
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/teamwork/test"
+)
+
+func TestMessageFields(t *testing.T) {
+	tests := []struct {
+		in                string
+		wantSubject       string
+		wantFrom          Address
+		wantSentAt        time.Time
+		wantPriority      int
+		wantMessageClass  string
+		wantMessageID     string
+		wantBodyHasPrefix string
+	}{
+		{
+			"triples",
+			"Sample Summary",
+			Address{Name: "Martin Rakhmanoff", AddressType: "SMTP", Email: "rakhmanoff@sundance.spb.ru"},
+			time.Date(2003, 5, 23, 13, 26, 17, 700000000, time.UTC),
+			PriorityNormal,
+			"IPM.Appointment",
+			"C326F5735704184D96EBD387444C618B",
+			"Sample description",
+		},
+		{
+			"unicode-mapi-attr-name",
+			"RE: [ZGLOSZENIE] THU#29044 Aktualizacja numerów w dodatkowych panelach",
+			Address{Name: "Marcin Jabłonkowski", AddressType: "SMTP", Email: "M.Jablonkowski@promedica24.pl"},
+			time.Date(2014, 6, 20, 10, 27, 10, 0, time.UTC),
+			PriorityNormal,
+			"IPM.Note",
+			"03243010448E7D4B8B3C7A2F0032076D",
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := Decode(test.Read(t, "./testdata", tt.in+".tnef"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if d.Subject != tt.wantSubject {
+				t.Errorf("wrong Subject: %q", d.Subject)
+			}
+			if d.From != tt.wantFrom {
+				t.Errorf("wrong From: %#v", d.From)
+			}
+			if !d.SentAt.Equal(tt.wantSentAt) {
+				t.Errorf("wrong SentAt: %v", d.SentAt)
+			}
+			if d.Priority != tt.wantPriority {
+				t.Errorf("wrong Priority: %d", d.Priority)
+			}
+			if d.MessageClass != tt.wantMessageClass {
+				t.Errorf("wrong MessageClass: %q", d.MessageClass)
+			}
+			if d.MessageID != tt.wantMessageID {
+				t.Errorf("wrong MessageID: %q", d.MessageID)
+			}
+			if d.TNEFVersion != 0x00010000 {
+				t.Errorf("wrong TNEFVersion: 0x%x", d.TNEFVersion)
+			}
+			if !bytes.HasPrefix(d.Body, []byte(tt.wantBodyHasPrefix)) {
+				t.Errorf("wrong Body: %q", d.Body)
+			}
+		})
+	}
+}
+
+// Only use the legacy attributes, without the MAPI properties.
+func TestMessageFieldsLegacy(t *testing.T) {
+	dec := NewDecoder(bytes.NewReader(test.Read(t, "./testdata", "triples.tnef")))
+	d := &Data{}
+	for {
+		o, err := dec.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o.Name == ATTMAPIPROPS {
+			continue
+		}
+
+		obj := tnefObject{Offset: o.Offset, Level: o.Level, Name: o.Name, Type: o.Type}
+		if obj.Data, err = o.Bytes(); err != nil {
+			t.Fatal(err)
+		}
+		if err := d.addAttr(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := d.legacyFields(); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Subject != "Sample Summary" {
+		t.Errorf("wrong Subject: %q", d.Subject)
+	}
+	want := Address{Name: "Martin Rakhmanoff", AddressType: "SMTP", Email: "rakhmanoff@sundance.spb.ru"}
+	if d.From != want {
+		t.Errorf("wrong From: %#v", d.From)
+	}
+	// The legacy dates are in the sender's local time, with an unknown time
+	// zone; with the MAPI properties SentAt is 13:26 UTC (see
+	// TestMessageFields).
+	if want := time.Date(2003, 5, 23, 17, 26, 17, 0, time.UTC); !d.SentAt.Equal(want) {
+		t.Errorf("wrong SentAt: %v", d.SentAt)
+	}
+	if want := time.Date(2003, 5, 23, 17, 26, 17, 0, time.UTC); !d.ReceivedAt.Equal(want) {
+		t.Errorf("wrong ReceivedAt: %v", d.ReceivedAt)
+	}
+	if d.Priority != PriorityNormal {
+		t.Errorf("wrong Priority: %d", d.Priority)
+	}
+	if d.MessageClass != "IPM.Appointment" {
+		t.Errorf("wrong MessageClass: %q", d.MessageClass)
+	}
+	if d.MessageID != "C326F5735704184D96EBD387444C618B" {
+		t.Errorf("wrong MessageID: %q", d.MessageID)
+	}
+	if string(d.Body) != "Sample description\r\n\x00" {
+		t.Errorf("wrong Body: %q", d.Body)
+	}
+	if d.Codepage != 1251 || d.Charset() != "windows-1251" {
+		t.Errorf("wrong Codepage: %d %q", d.Codepage, d.Charset())
+	}
+}
+
+// The legacy strings are decoded with the code page of the message, even if
+// it's only known after them.
+func TestMessageFieldsCodepage(t *testing.T) {
+	subject := []byte{0xcf, 0xf0, 0xe8, 0xe2, 0xe5, 0xf2, 0} // "Привет" in Windows-1251.
+	from := encodeTriple(1251, Address{Name: "Иван", AddressType: "SMTP", Email: "ivan@example.com"})
+
+	tests := []struct {
+		name  string
+		after func(e *encoder)
+	}{
+		{"ATTOEMCODEPAGE", func(e *encoder) {
+			e.object(lvlMessage, ATTOEMCODEPAGE, atpByte, append(le32(1251), le32(0)...))
+		}},
+		{"PR_MESSAGE_CODEPAGE", func(e *encoder) {
+			e.object(lvlMessage, ATTMAPIPROPS, atpByte, encodeMapi([]MAPIAttribute{
+				{Type: szmapiInt, Name: MAPIMessageCodepage, Data: le32(1251)},
+			}))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			e := &encoder{w: buf}
+			e.uint32(tnefSignature)
+			e.uint16(legacyKey)
+			e.object(lvlMessage, ATTSUBJECT, atpString, subject)
+			e.object(lvlMessage, ATTFROM, atpTriples, from)
+			tt.after(e)
+
+			out, err := DecodeWithOptions(buf.Bytes(), DecodeOptions{Strict: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out.Subject != "Привет" {
+				t.Errorf("wrong Subject: %q", out.Subject)
+			}
+			if out.From.Name != "Иван" {
+				t.Errorf("wrong From: %#v", out.From)
+			}
+			if out.Codepage != 1251 {
+				t.Errorf("wrong Codepage: %d", out.Codepage)
+			}
+		})
+	}
+}
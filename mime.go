@@ -0,0 +1,365 @@
+package tnef
+
+import (
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// WriteMIME writes the message as an RFC 5322 email message with MIME (RFC
+// 2045) parts, so it can be handled like any other email.
+//
+// The plain text and HTML bodies are written as a multipart/alternative, with
+// attachments that are referenced from the HTML with a "cid:" URL in a
+// multipart/related. Other attachments are added in a multipart/mixed, and
+// embedded messages as message/rfc822 parts.
+//
+// The headers are taken from the typed fields (Subject, From, Recipients, and
+// so on). Recipients without an SMTP address, such as Exchange addresses, are
+// written as an empty group with the display name. Groups aren't allowed in
+// From, so it's left out if the sender doesn't have an SMTP address.
+//
+// Header fields are encoded and folded so that the message only contains 7-bit
+// data.
+func (d *Data) WriteMIME(w io.Writer) error {
+	h := make(textproto.MIMEHeader)
+	if d.From != (Address{}) {
+		smtp := ""
+		for _, name := range []int{MAPISentRepresentingSmtpAddress, MAPISenderSmtpAddress} {
+			if attr, ok := findAttr(d.Attributes, name); ok {
+				if smtp, _ = attr.StringValue(); smtp != "" {
+					break
+				}
+			}
+		}
+		if from := mimeAddress(d.From, smtp); !strings.HasSuffix(from, ";") {
+			h.Set("From", from)
+		}
+	}
+
+	var to, cc []string
+	for _, r := range d.Recipients {
+		switch r.Type {
+		case RecipientTo:
+			to = append(to, mimeAddress(r.Address, r.SMTPAddress))
+		case RecipientCc:
+			cc = append(cc, mimeAddress(r.Address, r.SMTPAddress))
+		}
+	}
+	if len(to) > 0 {
+		h.Set("To", strings.Join(to, ", "))
+	}
+	if len(cc) > 0 {
+		h.Set("Cc", strings.Join(cc, ", "))
+	}
+
+	if d.Subject != "" {
+		h.Set("Subject", mime.QEncoding.Encode("utf-8", d.Subject))
+	}
+	if !d.SentAt.IsZero() {
+		h.Set("Date", d.SentAt.Format(mimeDateFormat))
+	}
+	for name, header := range map[int]string{
+		MAPIInternetMessageID:  "Message-Id",
+		MAPIInReplyToID:        "In-Reply-To",
+		MAPIInternetReferences: "References",
+	} {
+		if attr, ok := findAttr(d.Attributes, name); ok {
+			if s, _ := attr.StringValue(); s != "" {
+				h.Set(header, s)
+			}
+		}
+	}
+	switch d.Priority {
+	case PriorityHigh:
+		h.Set("Importance", "high")
+	case PriorityLow:
+		h.Set("Importance", "low")
+	}
+	h.Set("Mime-Version", "1.0")
+
+	e := d.mimeEntity()
+	for k, v := range e.header {
+		h[k] = v
+	}
+	if err := writeMIMEHeader(w, h); err != nil {
+		return err
+	}
+	return e.body(w)
+}
+
+// RFC 5322 date-time.
+const mimeDateFormat = "Mon, 02 Jan 2006 15:04:05 -0700"
+
+// mimeEntity is a MIME entity: the headers, and a function to write the
+// (encoded) body.
+type mimeEntity struct {
+	header textproto.MIMEHeader
+	body   func(w io.Writer) error
+}
+
+// mimeEntity gets the entity with the body and attachments of the message.
+func (d *Data) mimeEntity() *mimeEntity {
+	text, _ := d.TextBody()
+	html, _ := d.HTMLBody()
+
+	// Attachments referenced from the HTML are in a multipart/related with
+	// it; the others are added after the body.
+	var related, attached []*mimeEntity
+	for _, a := range d.Attachments {
+		if len(a.Data) == 0 && a.Embedded == nil {
+			continue
+		}
+		inline := a.ContentID != "" && len(html) > 0 &&
+			strings.Contains(html, "cid:"+strings.Trim(a.ContentID, "<>"))
+		if inline {
+			related = append(related, attachmentEntity(a, true))
+		} else {
+			attached = append(attached, attachmentEntity(a, false))
+		}
+	}
+
+	var body *mimeEntity
+	switch {
+	case len(html) > 0:
+		body = textEntity("text/html", "utf-8", []byte(html))
+		if len(related) > 0 {
+			body = multipartEntity("related", append([]*mimeEntity{body}, related...))
+		}
+		if text != "" {
+			body = multipartEntity("alternative", []*mimeEntity{
+				textEntity("text/plain", "utf-8", []byte(text)), body})
+		}
+	default:
+		body = textEntity("text/plain", "utf-8", []byte(text))
+	}
+
+	switch {
+	case len(attached) > 0 && len(html) == 0 && text == "":
+		// Don't add an empty body, e.g. for winmail.dat files with only
+		// attachments.
+		body = multipartEntity("mixed", attached)
+	case len(attached) > 0:
+		body = multipartEntity("mixed", append([]*mimeEntity{body}, attached...))
+	}
+	return body
+}
+
+// textEntity gets a text entity, which is encoded as quoted-printable.
+func textEntity(mediaType, charset string, text []byte) *mimeEntity {
+	params := map[string]string{}
+	if charset != "" {
+		params["charset"] = charset
+	}
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Type", mime.FormatMediaType(mediaType, params))
+	h.Set("Content-Transfer-Encoding", "quoted-printable")
+	return &mimeEntity{header: h, body: func(w io.Writer) error {
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write(text); err != nil {
+			return err
+		}
+		return qp.Close()
+	}}
+}
+
+// attachmentEntity gets the entity for an attachment; embedded messages are
+// written as message/rfc822.
+func attachmentEntity(a *Attachment, inline bool) *mimeEntity {
+	name := a.Filename()
+	h := make(textproto.MIMEHeader)
+
+	disposition := "attachment"
+	if inline {
+		disposition = "inline"
+	}
+	h.Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": name}))
+	if a.ContentID != "" {
+		h.Set("Content-Id", "<"+strings.Trim(a.ContentID, "<>")+">")
+	}
+
+	if a.Embedded != nil {
+		// Encoding isn't allowed for message/rfc822, but WriteMIME only
+		// writes 7-bit data: the header fields are encoded, and the
+		// bodies are quoted-printable or base64.
+		h.Set("Content-Type", "message/rfc822")
+		h.Set("Content-Transfer-Encoding", "7bit")
+		return &mimeEntity{header: h, body: a.Embedded.WriteMIME}
+	}
+
+	mediaType := a.MimeType
+	if _, _, err := mime.ParseMediaType(mediaType); err != nil || mediaType == "" {
+		mediaType = mime.TypeByExtension(filepath.Ext(name))
+	}
+	if mediaType == "" {
+		mediaType = "application/octet-stream"
+	}
+	if t, params, err := mime.ParseMediaType(mediaType); err == nil {
+		if _, ok := params["name"]; !ok {
+			params["name"] = name
+		}
+		mediaType = mime.FormatMediaType(t, params)
+	}
+	h.Set("Content-Type", mediaType)
+	h.Set("Content-Transfer-Encoding", "base64")
+
+	return &mimeEntity{header: h, body: func(w io.Writer) error {
+		return writeBase64(w, a.Data)
+	}}
+}
+
+// multipartEntity gets a multipart entity with the parts.
+func multipartEntity(subtype string, parts []*mimeEntity) *mimeEntity {
+	boundary := multipart.NewWriter(ioutil.Discard).Boundary()
+
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Type", mime.FormatMediaType("multipart/"+subtype, map[string]string{"boundary": boundary}))
+	return &mimeEntity{header: h, body: writeParts(boundary, parts)}
+}
+
+// writeParts gets a function to write the body of a multipart entity.
+func writeParts(boundary string, parts []*mimeEntity) func(w io.Writer) error {
+	return func(w io.Writer) error {
+		mw := multipart.NewWriter(w)
+		if err := mw.SetBoundary(boundary); err != nil {
+			return err
+		}
+		for _, p := range parts {
+			pw, err := mw.CreatePart(p.header)
+			if err != nil {
+				return err
+			}
+			if err := p.body(pw); err != nil {
+				return err
+			}
+		}
+		return mw.Close()
+	}
+}
+
+// writeMIMEHeader writes the header fields sorted by name, followed by the
+// blank line that separates them from the body.
+//
+// Values with non-ASCII or control characters are written as RFC 2047 encoded
+// words, and long lines are folded.
+func writeMIMEHeader(w io.Writer, h textproto.MIMEHeader) error {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		for _, v := range h[k] {
+			if !isPrintableASCII(v) {
+				v = mime.QEncoding.Encode("utf-8", v)
+			}
+			b.WriteString(foldHeader(k + ": " + v))
+		}
+	}
+	b.WriteString("\r\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Header lines are folded after this many characters if possible, as
+// recommended by RFC 5322.
+const headerLineLength = 78
+
+// foldHeader folds a header line at spaces so that lines are no longer than
+// headerLineLength if possible, and adds the CRLF.
+func foldHeader(line string) string {
+	var b strings.Builder
+	for len(line) > headerLineLength {
+		i := strings.LastIndexByte(line[:headerLineLength+1], ' ')
+		if i <= 0 {
+			// A single long word; fold after it instead.
+			if i = strings.IndexByte(line[headerLineLength:], ' '); i < 0 {
+				break
+			}
+			i += headerLineLength
+		}
+		b.WriteString(line[:i] + "\r\n")
+		line = line[i:]
+	}
+	b.WriteString(line + "\r\n")
+	return b.String()
+}
+
+// isPrintableASCII reports whether s only contains printable ASCII characters
+// and spaces.
+func isPrintableASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < ' ' || s[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// Line length of base64 data, as required by RFC 2045.
+const base64LineLength = 76
+
+func writeBase64(w io.Writer, data []byte) error {
+	enc := base64.StdEncoding.EncodeToString(data)
+	var b strings.Builder
+	for len(enc) > base64LineLength {
+		b.WriteString(enc[:base64LineLength] + "\r\n")
+		enc = enc[base64LineLength:]
+	}
+	b.WriteString(enc + "\r\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// mimeAddress formats an address for a header field. Addresses without an
+// SMTP address are written as an empty group, which keeps the display name.
+func mimeAddress(addr Address, smtp string) string {
+	email := smtp
+	if email == "" && strings.Contains(addr.Email, "@") {
+		email = addr.Email
+	}
+
+	name := mimePhrase(addr.Name)
+	switch {
+	case email == "":
+		if name == "" {
+			name = "undisclosed-recipients"
+		}
+		return name + ": ;"
+	case name == "":
+		return "<" + email + ">"
+	default:
+		return name + " <" + email + ">"
+	}
+}
+
+// mimePhrase formats a display name as an RFC 5322 phrase: as-is if it only
+// contains atoms, as a quoted string if it contains other ASCII characters, or
+// as an RFC 2047 encoded word.
+func mimePhrase(s string) string {
+	atom := true
+	for _, r := range s {
+		switch {
+		case r >= utf8.RuneSelf || r < ' ' || r == 0x7f:
+			return mime.QEncoding.Encode("utf-8", s)
+		case !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' ||
+			r == ' ' || strings.ContainsRune("!#$%&'*+-/=?^_`{|}~", r)):
+			atom = false
+		}
+	}
+	if atom {
+		return s
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,189 @@
+package tnef
+
+import (
+	"bytes"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/teamwork/test"
+)
+
+// mimeParts reads the leaf parts of a MIME entity, with the media type of
+// the multipart entities they're in prepended to their Content-Type.
+func mimeParts(t *testing.T, contentType string, body []byte) map[string]*multipart.Part {
+	t.Helper()
+	parts := map[string]*multipart.Part{}
+
+	var walk func(path, contentType string, body []byte)
+	walk = func(path, contentType string, body []byte) {
+		mediaType, params, err := mime.ParseMediaType(contentType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(mediaType, "multipart/") {
+			return
+		}
+
+		r := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		for {
+			p, err := r.NextPart()
+			if err != nil {
+				break
+			}
+			data, err := ioutil.ReadAll(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			partType := p.Header.Get("Content-Type")
+			key := path + mediaType + " " + strings.SplitN(partType, ";", 2)[0]
+			if name := p.FileName(); name != "" {
+				key += " " + name
+			}
+			parts[key] = p
+			walk(path+mediaType+" ", partType, data)
+		}
+	}
+	walk("", contentType, body)
+	return parts
+}
+
+func TestWriteMIME(t *testing.T) {
+	d, err := Decode(test.Read(t, "./testdata", "unicode-mapi-attr-name.tnef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := d.WriteMIME(buf); err != nil {
+		t.Fatal(err)
+	}
+	msg, err := mail.ReadMessage(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subject != d.Subject {
+		t.Errorf("wrong Subject: %q", subject)
+	}
+	from, err := msg.Header.AddressList("From")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (mail.Address{Name: "Marcin Jabłonkowski", Address: "M.Jablonkowski@promedica24.pl"}); len(from) != 1 || *from[0] != want {
+		t.Errorf("wrong From: %v", from)
+	}
+	if date, err := msg.Header.Date(); err != nil || !date.Equal(d.SentAt) {
+		t.Errorf("wrong Date: %v (%v)", date, err)
+	}
+	if id := msg.Header.Get("Message-Id"); id != "<3471F010E285B744A23B2B4A58D1FD3851E817DA@PM24-EX1.pm24.local>" {
+		t.Errorf("wrong Message-Id: %q", id)
+	}
+
+	body, err := ioutil.ReadAll(msg.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := mimeParts(t, msg.Header.Get("Content-Type"), body)
+	for _, key := range []string{
+		"multipart/mixed multipart/related",
+		"multipart/mixed multipart/related text/html",
+		"multipart/mixed multipart/related image/png image001.png",
+		"multipart/mixed multipart/related image/png image002.png",
+		"multipart/mixed multipart/related image/png image003.png",
+		"multipart/mixed application/octet-stream spaconsole2.cfg",
+	} {
+		if _, ok := parts[key]; !ok {
+			t.Errorf("part %q not found in %v", key, parts)
+		}
+	}
+	if cid := parts["multipart/mixed multipart/related image/png image001.png"].Header.Get("Content-Id"); cid != "<image001.png@01CF8C82.F4A2A290>" {
+		t.Errorf("wrong Content-Id: %q", cid)
+	}
+}
+
+func TestWriteMIMEAttachments(t *testing.T) {
+	refs := strings.Repeat("<0123456789abcdef.0123456789@mail.example.com> ", 4) + "<€@example.com>"
+	d := &Data{
+		Subject: "Report",
+		From:    Address{Name: "Alice", AddressType: "EX", Email: "/o=Example/cn=alice"},
+		Recipients: []Recipient{
+			{Address: Address{Name: "Bob, Jr.", AddressType: "SMTP", Email: "bob@example.com"}, Type: RecipientTo},
+			{Address: Address{Name: "Carol", AddressType: "EX", Email: "/o=Example/cn=carol"},
+				SMTPAddress: "carol@example.com", Type: RecipientCc},
+		},
+		SentAt:   time.Date(2020, 3, 4, 12, 0, 0, 0, time.UTC),
+		Priority: PriorityHigh,
+		Body:     []byte("See attached.\x00"),
+		Attributes: []MAPIAttribute{{Type: szmapiUnicodeString, Name: MAPIInternetReferences,
+			Data: encodeUnicode(refs)}},
+		Attachments: []*Attachment{
+			{LongFilename: "Übersicht.pdf", Data: []byte("%PDF-1.4")},
+			{Title: "Fwd.msg", Embedded: &Data{Subject: "Original", Body: []byte("Hello")}},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	if err := d.WriteMIME(buf); err != nil {
+		t.Fatal(err)
+	}
+	raw := buf.String()
+	msg, err := mail.ReadMessage(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for header, want := range map[string]string{
+		"From":       "", // No SMTP address, and groups aren't allowed.
+		"To":         `"Bob, Jr." <bob@example.com>`,
+		"Cc":         "Carol <carol@example.com>",
+		"Date":       "Wed, 04 Mar 2020 12:00:00 +0000",
+		"Importance": "high",
+	} {
+		if got := msg.Header.Get(header); got != want {
+			t.Errorf("wrong %s: %q", header, got)
+		}
+	}
+	// Long header fields are folded, and non-ASCII values encoded.
+	for _, line := range strings.Split(raw, "\r\n") {
+		if len(line) > 78 && !strings.HasPrefix(line, "--") {
+			t.Errorf("line too long: %q", line)
+		}
+	}
+	for i := 0; i < len(raw); i++ {
+		if raw[i] >= 0x80 {
+			t.Fatalf("8-bit data at %d: %q", i, raw[i:])
+		}
+	}
+	if got, _ := (&mime.WordDecoder{}).DecodeHeader(msg.Header.Get("References")); got != refs {
+		t.Errorf("wrong References: %q", got)
+	}
+
+	// Non-ASCII filenames are encoded as in RFC 2231.
+	if !strings.Contains(raw, `filename*=utf-8''%C3%9Cbersicht.pdf`) {
+		t.Errorf("filename not encoded:\n%s", raw)
+	}
+
+	body, err := ioutil.ReadAll(msg.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := mimeParts(t, msg.Header.Get("Content-Type"), body)
+	for _, key := range []string{
+		"multipart/mixed text/plain",
+		"multipart/mixed application/pdf Übersicht.pdf",
+		"multipart/mixed message/rfc822 Fwd.msg",
+	} {
+		if _, ok := parts[key]; !ok {
+			t.Errorf("part %q not found in %v", key, parts)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+//go:build ignore
+// +build ignore
+
+// Command mkproptags generates proptags.go and mapitags.go from proptags.txt
+// and namedprops.txt.
+//
+// Usage:
+//
+//	go run mkproptags.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Property types, as named in [MS-OXPROPS] without the Ptyp prefix.
+var types = map[string]struct {
+	code int
+	name string
+}{
+	"Integer16":    {0x0002, "szmapiShort"},
+	"Integer32":    {0x0003, "szmapiInt"},
+	"Floating32":   {0x0004, "szmapiFloat"},
+	"Floating64":   {0x0005, "szmapiDouble"},
+	"Currency":     {0x0006, "szmapiCurrency"},
+	"FloatingTime": {0x0007, "szmapiApptime"},
+	"ErrorCode":    {0x000A, "szmapiError"},
+	"Boolean":      {0x000B, "szmapiBoolean"},
+	"Object":       {0x000D, "szmapiObject"},
+	"Integer64":    {0x0014, "szmapiInt8byte"},
+	"String8":      {0x001E, "szmapiString"},
+	"String":       {0x001F, "szmapiUnicodeString"},
+	"Time":         {0x0040, "szmapiSystime"},
+	"Guid":         {0x0048, "szmapiCLSID"},
+	"Binary":       {0x0102, "szmapiBinary"},
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mkproptags: ")
+
+	props, err := readTable("proptags.txt", 5)
+	if err != nil {
+		log.Fatal(err)
+	}
+	named, err := readTable("namedprops.txt", 4)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tags := new(bytes.Buffer)
+	fmt.Fprint(tags, header)
+	fmt.Fprint(tags, "// propTags lists the properties in [MS-OXPROPS] and mapitags.h by ID. If\n")
+	fmt.Fprint(tags, "// several types share an ID the most common one is first.\n")
+	fmt.Fprint(tags, "var propTags = []propTagInfo{\n")
+	for _, f := range props {
+		if f[1] == "-" {
+			continue
+		}
+		code, _, err := parseType(f[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		id, err := parseID(f[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(tags, "\t{0x%04X%04X, %q, %q},\n", id, code, dash(f[2]), dash(f[3]))
+	}
+	fmt.Fprint(tags, "}\n\n")
+	fmt.Fprint(tags, "// namedProps lists the named properties with a numeric ID in [MS-OXPROPS].\n")
+	fmt.Fprint(tags, "var namedProps = []namedPropInfo{\n")
+	for _, f := range named {
+		_, typ, err := parseType(f[2])
+		if err != nil {
+			log.Fatal(err)
+		}
+		lid, err := parseID(f[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(tags, "\t{NamedProp{%s, 0x%04X}, %s, %q},\n", f[0], lid, typ, f[3])
+	}
+	fmt.Fprint(tags, "}\n")
+
+	consts := new(bytes.Buffer)
+	fmt.Fprint(consts, header)
+	fmt.Fprint(consts, "// We can use these constants to find specific types\n")
+	fmt.Fprint(consts, "// of MAPIAttribute by comparing it to the type of the\n")
+	fmt.Fprint(consts, "// attribute.\n")
+	fmt.Fprint(consts, "const (\n")
+	seen := make(map[string]bool)
+	for _, f := range props {
+		if f[4] == "-" {
+			continue
+		}
+		if seen[f[4]] {
+			log.Fatalf("duplicate constant %s", f[4])
+		}
+		seen[f[4]] = true
+		id, err := parseID(f[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+		name := dash(f[2])
+		if name == "" {
+			name = dash(f[3])
+		}
+		if name != "" {
+			name = " // " + name
+		}
+		fmt.Fprintf(consts, "\t%s = 0x%04X%s\n", f[4], id, name)
+	}
+	fmt.Fprint(consts, ")\n")
+
+	if err := write("proptags.go", tags.Bytes()); err != nil {
+		log.Fatal(err)
+	}
+	if err := write("mapitags.go", consts.Bytes()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+const header = "// Code generated by mkproptags.go; DO NOT EDIT.\n\npackage tnef\n\n"
+
+// readTable reads the lines of a table with n columns, skipping comments.
+func readTable(path string, n int) ([][]string, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close() // nolint: errcheck
+
+	var rows [][]string
+	scanner := bufio.NewScanner(fp)
+	for i := 1; scanner.Scan(); i++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) != n {
+			return nil, fmt.Errorf("%s:%d: %d columns, want %d", path, i, len(f), n)
+		}
+		rows = append(rows, f)
+	}
+	return rows, scanner.Err()
+}
+
+// parseType gets the code and the name of the constant of an [MS-OXPROPS]
+// type, which may have the Multiple prefix.
+func parseType(s string) (int, string, error) {
+	t, ok := types[strings.TrimPrefix(s, "Multiple")]
+	if !ok {
+		return 0, "", fmt.Errorf("unknown type %q", s)
+	}
+	if strings.HasPrefix(s, "Multiple") {
+		return t.code | 0x1000, t.name + " | mvFlag", nil
+	}
+	return t.code, t.name, nil
+}
+
+func parseID(s string) (int, error) {
+	id, err := strconv.ParseUint(strings.TrimPrefix(s, "0x"), 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ID %q", s)
+	}
+	return int(id), nil
+}
+
+// dash converts the "-" placeholder to an empty string.
+func dash(s string) string {
+	if s == "-" {
+		return ""
+	}
+	return s
+}
+
+func write(path string, src []byte) error {
+	src, err := format.Source(src)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, src, 0644)
+}
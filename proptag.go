@@ -0,0 +1,179 @@
+package tnef
+
+//go:generate go run mkproptags.go
+
+import "fmt"
+
+// PropTag is a MAPI property tag: the property ID in the high 16 bits, and the
+// property type in the low 16 bits. For example 0x37010102 is the binary
+// attachment data, PidTagAttachDataBinary.
+//
+// The names are from a registry which has the mapitags.h properties that the
+// MAPI constants are based on and the [MS-OXPROPS] properties that are
+// commonly found in TNEF files, but not the complete [MS-OXPROPS] list; other
+// properties are formatted as their tag or property set and ID. See
+// proptags.txt and namedprops.txt to add more.
+type PropTag uint32
+
+// NewPropTag makes a tag from a property ID (such as MAPIAttachDataObj) and a
+// type (which includes the multi-value flag 0x1000).
+func NewPropTag(id, typ int) PropTag {
+	return PropTag(uint32(id)<<16 | uint32(typ)&0xFFFF)
+}
+
+// ID gets the property ID, which can be compared to the MAPI constants.
+func (t PropTag) ID() int { return int(t >> 16) }
+
+// Type gets the property type.
+func (t PropTag) Type() int { return int(t & 0xFFFF) }
+
+// String gets the name of the property as used in [MS-OXPROPS], e.g.
+// PidTagAttachDataBinary. Properties which are only in the MAPI headers get the
+// PR_ name, and unknown properties are formatted as 0x37010102.
+func (t PropTag) String() string {
+	if p := lookupPropTag(t); p != nil {
+		if p.name != "" {
+			return p.name
+		}
+		return p.prName
+	}
+	return fmt.Sprintf("0x%08X", uint32(t))
+}
+
+// PRName gets the name of the property as used in the MAPI headers (mapitags.h),
+// e.g. PR_ATTACH_DATA_BIN, or "" if it's unknown.
+func (t PropTag) PRName() string {
+	if p := lookupPropTag(t); p != nil {
+		return p.prName
+	}
+	return ""
+}
+
+// PropTagByName looks up a property by its [MS-OXPROPS] name (e.g.
+// PidTagSubject) or PR_ name (e.g. PR_SUBJECT).
+func PropTagByName(name string) (PropTag, bool) {
+	t, ok := propTagsByName[name]
+	return t, ok
+}
+
+// NamedProp identifies a named property by its property set and numeric ID
+// (LID). Named properties with a string name are not in the registry.
+type NamedProp struct {
+	Set GUID
+	ID  int32
+}
+
+// String gets the name of the property as used in [MS-OXPROPS], e.g.
+// PidLidAppointmentStartWhole, or the property set and ID if it's unknown.
+func (p NamedProp) String() string {
+	if n, ok := namedPropNames[p]; ok {
+		return n.name
+	}
+	return fmt.Sprintf("%s/0x%04X", p.Set, p.ID)
+}
+
+// Type gets the type of the property as given in [MS-OXPROPS], or 0 if it's
+// unknown.
+func (p NamedProp) Type() int {
+	return namedPropNames[p].typ
+}
+
+// NamedPropByName looks up a named property by its [MS-OXPROPS] name, e.g.
+// PidLidAppointmentStartWhole.
+func NamedPropByName(name string) (NamedProp, bool) {
+	p, ok := namedPropsByName[name]
+	return p, ok
+}
+
+// Tag gets the property tag. For named properties this is only valid in this
+// TNEF stream; use NamedProp instead.
+func (a MAPIAttribute) Tag() PropTag {
+	typ := a.Type
+	if a.IsMultiValue {
+		typ |= mvFlag
+	}
+	return NewPropTag(a.Name, typ)
+}
+
+// NamedProp gets the property set and ID of a named property; ok is false if
+// this isn't a named property with a numeric ID.
+func (a MAPIAttribute) NamedProp() (p NamedProp, ok bool) {
+	if a.Name < 0x8000 || a.NamedString != "" {
+		return NamedProp{}, false
+	}
+	return NamedProp{Set: a.PropSet, ID: a.NamedID}, true
+}
+
+// PropName gets a readable name of the property, for example for logging:
+// PidTagSubject, PidLidAppointmentStartWhole, or the string name of a named
+// property. Unknown properties are formatted as 0x3701.
+func (a MAPIAttribute) PropName() string {
+	if a.Name >= 0x8000 {
+		if a.NamedString != "" {
+			return a.NamedString
+		}
+		p, _ := a.NamedProp()
+		return p.String()
+	}
+	if lookupPropTag(a.Tag()) == nil {
+		return fmt.Sprintf("0x%04X", a.Name)
+	}
+	return a.Tag().String()
+}
+
+type propTagInfo struct {
+	tag    PropTag
+	name   string // [MS-OXPROPS] name; empty if it's only in mapitags.h.
+	prName string // mapitags.h name; empty if it's only in [MS-OXPROPS].
+}
+
+type namedPropInfo struct {
+	NamedProp
+	typ  int
+	name string
+}
+
+var (
+	propTagsByTag    = make(map[PropTag]*propTagInfo)
+	propTagsByID     = make(map[int]*propTagInfo)
+	propTagsByName   = make(map[string]PropTag)
+	namedPropNames   = make(map[NamedProp]namedPropInfo)
+	namedPropsByName = make(map[string]NamedProp)
+)
+
+func init() {
+	for i := range propTags {
+		p := &propTags[i]
+		propTagsByTag[p.tag] = p
+		// The first one is the most common if several types share an ID.
+		if _, ok := propTagsByID[p.tag.ID()]; !ok {
+			propTagsByID[p.tag.ID()] = p
+		}
+		if p.name != "" {
+			propTagsByName[p.name] = p.tag
+		}
+		if p.prName != "" {
+			propTagsByName[p.prName] = p.tag
+		}
+	}
+	for _, p := range namedProps {
+		namedPropNames[p.NamedProp] = p
+		namedPropsByName[p.name] = p.NamedProp
+	}
+}
+
+// lookupPropTag finds the property in the registry. 8-bit strings are listed as
+// Unicode strings, and if the type is unexpected the property with the same ID
+// is used.
+func lookupPropTag(t PropTag) *propTagInfo {
+	switch t.Type() {
+	case szmapiString:
+		t = NewPropTag(t.ID(), szmapiUnicodeString)
+	case szmapiString | mvFlag:
+		t = NewPropTag(t.ID(), szmapiUnicodeString|mvFlag)
+	}
+	if p, ok := propTagsByTag[t]; ok {
+		return p
+	}
+	return propTagsByID[t.ID()]
+}
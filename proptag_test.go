@@ -0,0 +1,142 @@
+package tnef
+
+import "testing"
+
+func TestPropTag(t *testing.T) {
+	tests := []struct {
+		tag          PropTag
+		name, prName string
+	}{
+		{0x37010102, "PidTagAttachDataBinary", "PR_ATTACH_DATA_BIN"},
+		{0x3701000D, "PidTagAttachDataObject", "PR_ATTACH_DATA_OBJ"},
+		{0x0037001F, "PidTagSubject", "PR_SUBJECT"},
+		{0x0037001E, "PidTagSubject", "PR_SUBJECT"},
+		{0x10130102, "PidTagHtml", "PR_HTML"},
+		{0x1013001E, "PidTagBodyHtml", "PR_BODY_HTML"},
+		{0x3A58101E, "PidTagChildrensNames", "PR_CHILDRENS_NAMES"},
+		{0x00010003, "PR_ACKNOWLEDGEMENT_MODE", "PR_ACKNOWLEDGEMENT_MODE"},
+		{0x3FDE0102, "PidTagInternetCodepage", "PR_INTERNET_CPID"}, // Wrong type.
+		{0x12340003, "0x12340003", ""},
+	}
+	for _, tt := range tests {
+		if got := tt.tag.String(); got != tt.name {
+			t.Errorf("0x%08X: got name %q, want %q", uint32(tt.tag), got, tt.name)
+		}
+		if got := tt.tag.PRName(); got != tt.prName {
+			t.Errorf("0x%08X: got PR name %q, want %q", uint32(tt.tag), got, tt.prName)
+		}
+	}
+
+	if tag := NewPropTag(MAPIAttachDataObj, szmapiBinary); tag != 0x37010102 || tag.ID() != MAPIAttachDataObj || tag.Type() != szmapiBinary {
+		t.Errorf("wrong tag 0x%08X", uint32(tag))
+	}
+
+	for _, name := range []string{"PidTagAttachDataBinary", "PR_ATTACH_DATA_BIN"} {
+		if tag, ok := PropTagByName(name); !ok || tag != 0x37010102 {
+			t.Errorf("%s: got 0x%08X, %t", name, uint32(tag), ok)
+		}
+	}
+	if _, ok := PropTagByName("PidTagNope"); ok {
+		t.Error("found PidTagNope")
+	}
+
+	// Every name is unique, and looks up the same tag.
+	seen := make(map[string]bool)
+	for _, p := range propTags {
+		for _, name := range []string{p.name, p.prName} {
+			if name == "" {
+				continue
+			}
+			if seen[name] {
+				t.Errorf("duplicate name %s", name)
+			}
+			seen[name] = true
+			if tag, _ := PropTagByName(name); tag != p.tag {
+				t.Errorf("%s: got 0x%08X, want 0x%08X", name, uint32(tag), uint32(p.tag))
+			}
+		}
+	}
+}
+
+func TestNamedProp(t *testing.T) {
+	p := NamedProp{PSETIDAppointment, 0x820D}
+	if got := p.String(); got != "PidLidAppointmentStartWhole" {
+		t.Errorf("got %q", got)
+	}
+	if p.Type() != szmapiSystime {
+		t.Errorf("wrong type 0x%04x", p.Type())
+	}
+	if got, ok := NamedPropByName("PidLidAppointmentStartWhole"); !ok || got != p {
+		t.Errorf("got %v, %t", got, ok)
+	}
+	// The same LID in another set is something else.
+	if got := (NamedProp{PSETIDTask, 0x820D}).String(); got != "{00062003-0000-0000-C000-000000000046}/0x820D" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestMAPIAttributePropName(t *testing.T) {
+	tests := []struct {
+		in   MAPIAttribute
+		want string
+	}{
+		{MAPIAttribute{Name: MAPISubject, Type: szmapiString}, "PidTagSubject"},
+		{MAPIAttribute{Name: 0x6666, Type: szmapiInt}, "0x6666"},
+		{MAPIAttribute{Name: 0x8001, Type: szmapiSystime, PropSet: PSETIDAppointment, NamedID: 0x820D}, "PidLidAppointmentStartWhole"},
+		{MAPIAttribute{Name: 0x8002, Type: szmapiString, PropSet: PSInternetHeaders, NamedString: "x-mailer"}, "x-mailer"},
+	}
+	for _, tt := range tests {
+		if got := tt.in.PropName(); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+
+	_, err := MAPIAttribute{Name: MAPISubject, Type: szmapiString}.Int()
+	if want := "wrong property type: property PidTagSubject has type 0x001e, not integer"; err == nil || err.Error() != want {
+		t.Errorf("wrong error: %v", err)
+	}
+}
+
+func TestMAPIConstants(t *testing.T) {
+	// Well-known values from [MS-OXPROPS].
+	tests := []struct {
+		got  int
+		name string
+		want int
+	}{
+		{MAPISubject, "PR_SUBJECT", 0x0037},
+		{MAPIMessageClass, "PR_MESSAGE_CLASS", 0x001A},
+		{MAPISenderEmailAddress, "PR_SENDER_EMAIL_ADDRESS", 0x0C1F},
+		{MAPIBody, "PR_BODY", 0x1000},
+		{MAPIHTML, "PR_HTML", 0x1013},
+		{MAPIBodyHTML, "PR_BODY_HTML", 0x1013},
+		{MAPIRtfCompressed, "PR_RTF_COMPRESSED", 0x1009},
+		{MAPIInternetCPID, "PR_INTERNET_CPID", 0x3FDE},
+		{MAPIMessageCodepage, "PR_MESSAGE_CODEPAGE", 0x3FFD},
+		{MAPIAttachDataBin, "PR_ATTACH_DATA_BIN", 0x3701},
+		{MAPIAttachDataObj, "PR_ATTACH_DATA_OBJ", 0x3701},
+		{MAPIAttachFilename, "PR_ATTACH_FILENAME", 0x3704},
+		{MAPIAttachMethod, "PR_ATTACH_METHOD", 0x3705},
+		{MAPIAttachLongFilename, "PR_ATTACH_LONG_FILENAME", 0x3707},
+		{MAPIAttachMimeTag, "PR_ATTACH_MIME_TAG", 0x370E},
+		{MAPIAttachContentID, "PR_ATTACH_CONTENT_ID", 0x3712},
+		{MAPIAttachFlags, "PR_ATTACH_FLAGS", 0x3714},
+		{MAPIIpmID, "PR_IPM_ID", 0x0018},
+		{MAPIDisplayName, "PR_DISPLAY_NAME", 0x3001},
+		{MAPISmtpAddress, "PR_SMTP_ADDRESS", 0x39FE},
+		{MAPIGender, "PR_GENDER", 0x3A4D},
+		{MAPIHomeAddressCity, "PR_HOME_ADDRESS_CITY", 0x3A59},
+		{MAPIOtherAddressPostOfficeBox, "PR_OTHER_ADDRESS_POST_OFFICE_BOX", 0x3A64},
+		{MAPISenderSmtpAddress, "PR_SENDER_SMTP_ADDRESS", 0x5D01},
+		{MAPIRecipientTrackStatus, "PidTagRecipientTrackStatus", 0x5FFF},
+		{MAPIAttachmentHidden, "PidTagAttachmentHidden", 0x7FFE},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got 0x%04X, want 0x%04X", tt.name, tt.got, tt.want)
+		}
+		if tag, ok := PropTagByName(tt.name); !ok || tag.ID() != tt.want {
+			t.Errorf("%s: registry has 0x%04X", tt.name, tag.ID())
+		}
+	}
+}
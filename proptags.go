@@ -0,0 +1,774 @@
+// Code generated by mkproptags.go; DO NOT EDIT.
+
+package tnef
+
+// propTags lists the properties in [MS-OXPROPS] and mapitags.h by ID. If
+// several types share an ID the most common one is first.
+var propTags = []propTagInfo{
+	{0x00010003, "", "PR_ACKNOWLEDGEMENT_MODE"},
+	{0x00010102, "PidTagTemplateData", ""},
+	{0x0002000B, "PidTagAlternateRecipientAllowed", "PR_ALTERNATE_RECIPIENT_ALLOWED"},
+	{0x00030102, "", "PR_AUTHORIZING_USERS"},
+	{0x0004001F, "PidTagAutoForwardComment", "PR_AUTO_FORWARD_COMMENT"},
+	{0x00040102, "PidTagScriptData", ""},
+	{0x0005000B, "PidTagAutoForwarded", "PR_AUTO_FORWARDED"},
+	{0x00060102, "", "PR_CONTENT_CONFIDENTIALITY_ALGORITHM_ID"},
+	{0x00070102, "", "PR_CONTENT_CORRELATOR"},
+	{0x0008001F, "", "PR_CONTENT_IDENTIFIER"},
+	{0x00090003, "", "PR_CONTENT_LENGTH"},
+	{0x000A000B, "", "PR_CONTENT_RETURN_REQUESTED"},
+	{0x000B0102, "", "PR_CONVERSATION_KEY"},
+	{0x000C0102, "", "PR_CONVERSION_EITS"},
+	{0x000D000B, "", "PR_CONVERSION_WITH_LOSS_PROHIBITED"},
+	{0x000E0102, "", "PR_CONVERTED_EITS"},
+	{0x000F0040, "PidTagDeferredDeliveryTime", "PR_DEFERRED_DELIVERY_TIME"},
+	{0x00100040, "PidTagDeliverTime", "PR_DELIVER_TIME"},
+	{0x00110003, "", "PR_DISCARD_REASON"},
+	{0x0012000B, "", "PR_DISCLOSURE_OF_RECIPIENTS"},
+	{0x00130102, "", "PR_DL_EXPANSION_HISTORY"},
+	{0x0014000B, "", "PR_DL_EXPANSION_PROHIBITED"},
+	{0x00150040, "PidTagExpiryTime", "PR_EXPIRY_TIME"},
+	{0x0016000B, "", "PR_IMPLICIT_CONVERSION_PROHIBITED"},
+	{0x00170003, "PidTagImportance", "PR_IMPORTANCE"},
+	{0x00180102, "", "PR_IPM_ID"},
+	{0x00190040, "", "PR_LATEST_DELIVERY_TIME"},
+	{0x001A001F, "PidTagMessageClass", "PR_MESSAGE_CLASS"},
+	{0x001B0102, "", "PR_MESSAGE_DELIVERY_ID"},
+	{0x001E0102, "", "PR_MESSAGE_SECURITY_LABEL"},
+	{0x001F0102, "", "PR_OBSOLETED_IPMS"},
+	{0x00200102, "", "PR_ORIGINALLY_INTENDED_RECIPIENT_NAME"},
+	{0x00210102, "", "PR_ORIGINAL_EITS"},
+	{0x00220102, "", "PR_ORIGINATOR_CERTIFICATE"},
+	{0x0023000B, "PidTagOriginatorDeliveryReportRequested", "PR_ORIGINATOR_DELIVERY_REPORT_REQUESTED"},
+	{0x00240102, "", "PR_ORIGINATOR_RETURN_ADDRESS"},
+	{0x00250102, "PidTagParentKey", "PR_PARENT_KEY"},
+	{0x00260003, "PidTagPriority", "PR_PRIORITY"},
+	{0x00270102, "", "PR_ORIGIN_CHECK"},
+	{0x0028000B, "", "PR_PROOF_OF_SUBMISSION_REQUESTED"},
+	{0x0029000B, "PidTagReadReceiptRequested", "PR_READ_RECEIPT_REQUESTED"},
+	{0x002A0040, "PidTagReceiptTime", "PR_RECEIPT_TIME"},
+	{0x002B000B, "PidTagRecipientReassignmentProhibited", "PR_RECIPIENT_REASSIGNMENT_PROHIBITED"},
+	{0x002C0102, "", "PR_REDIRECTION_HISTORY"},
+	{0x002D0102, "", "PR_RELATED_IPMS"},
+	{0x002E0003, "PidTagOriginalSensitivity", "PR_ORIGINAL_SENSITIVITY"},
+	{0x002F001F, "", "PR_LANGUAGES"},
+	{0x00300040, "PidTagReplyTime", "PR_REPLY_TIME"},
+	{0x00310102, "PidTagReportTag", "PR_REPORT_TAG"},
+	{0x00320040, "PidTagReportTime", "PR_REPORT_TIME"},
+	{0x0033000B, "", "PR_RETURNED_IPM"},
+	{0x00340003, "", "PR_SECURITY"},
+	{0x0035000B, "PidTagIncompleteCopy", "PR_INCOMPLETE_COPY"},
+	{0x00360003, "PidTagSensitivity", "PR_SENSITIVITY"},
+	{0x0037001F, "PidTagSubject", "PR_SUBJECT"},
+	{0x00380102, "", "PR_SUBJECT_IPM"},
+	{0x00390040, "PidTagClientSubmitTime", "PR_CLIENT_SUBMIT_TIME"},
+	{0x003A001F, "PidTagReportName", "PR_REPORT_NAME"},
+	{0x003B0102, "PidTagSentRepresentingSearchKey", "PR_SENT_REPRESENTING_SEARCH_KEY"},
+	{0x003C0102, "", "PR_X400_CONTENT_TYPE"},
+	{0x003D001F, "PidTagSubjectPrefix", "PR_SUBJECT_PREFIX"},
+	{0x003E0003, "", "PR_NON_RECEIPT_REASON"},
+	{0x003F0102, "PidTagReceivedByEntryId", "PR_RECEIVED_BY_ENTRYID"},
+	{0x0040001F, "PidTagReceivedByName", "PR_RECEIVED_BY_NAME"},
+	{0x00410102, "PidTagSentRepresentingEntryId", "PR_SENT_REPRESENTING_ENTRYID"},
+	{0x0042001F, "PidTagSentRepresentingName", "PR_SENT_REPRESENTING_NAME"},
+	{0x00430102, "PidTagReceivedRepresentingEntryId", "PR_RCVD_REPRESENTING_ENTRYID"},
+	{0x0044001F, "PidTagReceivedRepresentingName", "PR_RCVD_REPRESENTING_NAME"},
+	{0x00450102, "PidTagReportEntryId", "PR_REPORT_ENTRYID"},
+	{0x00460102, "PidTagReadReceiptEntryId", "PR_READ_RECEIPT_ENTRYID"},
+	{0x00470102, "PidTagMessageSubmissionId", "PR_MESSAGE_SUBMISSION_ID"},
+	{0x00480040, "PidTagProviderSubmitTime", "PR_PROVIDER_SUBMIT_TIME"},
+	{0x0049001F, "PidTagOriginalSubject", "PR_ORIGINAL_SUBJECT"},
+	{0x004A000B, "", "PR_DISC_VAL"},
+	{0x004B001F, "PidTagOriginalMessageClass", "PR_ORIG_MESSAGE_CLASS"},
+	{0x004C0102, "PidTagOriginalAuthorEntryId", "PR_ORIGINAL_AUTHOR_ENTRYID"},
+	{0x004D001F, "PidTagOriginalAuthorName", "PR_ORIGINAL_AUTHOR_NAME"},
+	{0x004E0040, "PidTagOriginalSubmitTime", "PR_ORIGINAL_SUBMIT_TIME"},
+	{0x004F0102, "PidTagReplyRecipientEntries", "PR_REPLY_RECIPIENT_ENTRIES"},
+	{0x0050001F, "PidTagReplyRecipientNames", "PR_REPLY_RECIPIENT_NAMES"},
+	{0x00510102, "PidTagReceivedBySearchKey", "PR_RECEIVED_BY_SEARCH_KEY"},
+	{0x00520102, "PidTagReceivedRepresentingSearchKey", "PR_RCVD_REPRESENTING_SEARCH_KEY"},
+	{0x00530102, "PidTagReadReceiptSearchKey", "PR_READ_RECEIPT_SEARCH_KEY"},
+	{0x00540102, "PidTagReportSearchKey", "PR_REPORT_SEARCH_KEY"},
+	{0x00550040, "PidTagOriginalDeliveryTime", "PR_ORIGINAL_DELIVERY_TIME"},
+	{0x00560102, "", "PR_ORIGINAL_AUTHOR_SEARCH_KEY"},
+	{0x0057000B, "PidTagMessageToMe", "PR_MESSAGE_TO_ME"},
+	{0x0058000B, "PidTagMessageCcMe", "PR_MESSAGE_CC_ME"},
+	{0x0059000B, "PidTagMessageRecipientMe", "PR_MESSAGE_RECIP_ME"},
+	{0x005A001F, "PidTagOriginalSenderName", "PR_ORIGINAL_SENDER_NAME"},
+	{0x005B0102, "PidTagOriginalSenderEntryId", "PR_ORIGINAL_SENDER_ENTRYID"},
+	{0x005C0102, "PidTagOriginalSenderSearchKey", "PR_ORIGINAL_SENDER_SEARCH_KEY"},
+	{0x005D001F, "PidTagOriginalSentRepresentingName", "PR_ORIGINAL_SENT_REPRESENTING_NAME"},
+	{0x005E0102, "PidTagOriginalSentRepresentingEntryId", "PR_ORIGINAL_SENT_REPRESENTING_ENTRYID"},
+	{0x005F0102, "PidTagOriginalSentRepresentingSearchKey", "PR_ORIGINAL_SENT_REPRESENTING_SEARCH_KEY"},
+	{0x00600040, "PidTagStartDate", "PR_START_DATE"},
+	{0x00610040, "PidTagEndDate", "PR_END_DATE"},
+	{0x00620003, "PidTagOwnerAppointmentId", "PR_OWNER_APPT_ID"},
+	{0x0063000B, "PidTagResponseRequested", "PR_RESPONSE_REQUESTED"},
+	{0x0064001F, "PidTagSentRepresentingAddressType", "PR_SENT_REPRESENTING_ADDRTYPE"},
+	{0x0065001F, "PidTagSentRepresentingEmailAddress", "PR_SENT_REPRESENTING_EMAIL_ADDRESS"},
+	{0x0066001F, "PidTagOriginalSenderAddressType", "PR_ORIGINAL_SENDER_ADDRTYPE"},
+	{0x0067001F, "PidTagOriginalSenderEmailAddress", "PR_ORIGINAL_SENDER_EMAIL_ADDRESS"},
+	{0x0068001F, "PidTagOriginalSentRepresentingAddressType", "PR_ORIGINAL_SENT_REPRESENTING_ADDRTYPE"},
+	{0x0069001F, "PidTagOriginalSentRepresentingEmailAddress", "PR_ORIGINAL_SENT_REPRESENTING_EMAIL_ADDRESS"},
+	{0x0070001F, "PidTagConversationTopic", "PR_CONVERSATION_TOPIC"},
+	{0x00710102, "PidTagConversationIndex", "PR_CONVERSATION_INDEX"},
+	{0x0072001F, "PidTagOriginalDisplayBcc", "PR_ORIGINAL_DISPLAY_BCC"},
+	{0x0073001F, "PidTagOriginalDisplayCc", "PR_ORIGINAL_DISPLAY_CC"},
+	{0x0074001F, "PidTagOriginalDisplayTo", "PR_ORIGINAL_DISPLAY_TO"},
+	{0x0075001F, "PidTagReceivedByAddressType", "PR_RECEIVED_BY_ADDRTYPE"},
+	{0x0076001F, "PidTagReceivedByEmailAddress", "PR_RECEIVED_BY_EMAIL_ADDRESS"},
+	{0x0077001F, "PidTagReceivedRepresentingAddressType", "PR_RCVD_REPRESENTING_ADDRTYPE"},
+	{0x0078001F, "PidTagReceivedRepresentingEmailAddress", "PR_RCVD_REPRESENTING_EMAIL_ADDRESS"},
+	{0x0079001F, "", "PR_ORIGINAL_AUTHOR_ADDRTYPE"},
+	{0x007A001F, "", "PR_ORIGINAL_AUTHOR_EMAIL_ADDRESS"},
+	{0x007B001F, "", "PR_ORIGINALLY_INTENDED_RECIP_ADDRTYPE"},
+	{0x007C001F, "", "PR_ORIGINALLY_INTENDED_RECIP_EMAIL_ADDRESS"},
+	{0x007D001F, "PidTagTransportMessageHeaders", "PR_TRANSPORT_MESSAGE_HEADERS"},
+	{0x007E0102, "", "PR_DELEGATION"},
+	{0x007F0102, "PidTagTnefCorrelationKey", "PR_TNEF_CORRELATION_KEY"},
+	{0x0080001F, "PidTagReportDisposition", ""},
+	{0x0081001F, "PidTagReportDispositionMode", ""},
+	{0x0C000102, "", "PR_CONTENT_INTEGRITY_CHECK"},
+	{0x0C010003, "", "PR_EXPLICIT_CONVERSION"},
+	{0x0C02000B, "", "PR_IPM_RETURN_REQUESTED"},
+	{0x0C030102, "", "PR_MESSAGE_TOKEN"},
+	{0x0C040003, "PidTagNonDeliveryReportReasonCode", "PR_NDR_REASON_CODE"},
+	{0x0C050003, "PidTagNonDeliveryReportDiagCode", "PR_NDR_DIAG_CODE"},
+	{0x0C06000B, "PidTagNonReceiptNotificationRequested", "PR_NON_RECEIPT_NOTIFICATION_REQUESTED"},
+	{0x0C070003, "", "PR_DELIVERY_POINT"},
+	{0x0C08000B, "PidTagOriginatorNonDeliveryReportRequested", "PR_ORIGINATOR_NON_DELIVERY_REPORT_REQUESTED"},
+	{0x0C090102, "", "PR_ORIGINATOR_REQUESTED_ALTERNATE_RECIPIENT"},
+	{0x0C0A000B, "", "PR_PHYSICAL_DELIVERY_BUREAU_FAX_DELIVERY"},
+	{0x0C0B0003, "", "PR_PHYSICAL_DELIVERY_MODE"},
+	{0x0C0C0003, "", "PR_PHYSICAL_DELIVERY_REPORT_REQUEST"},
+	{0x0C0D0102, "", "PR_PHYSICAL_FORWARDING_ADDRESS"},
+	{0x0C0E000B, "", "PR_PHYSICAL_FORWARDING_ADDRESS_REQUESTED"},
+	{0x0C0F000B, "", "PR_PHYSICAL_FORWARDING_PROHIBITED"},
+	{0x0C100102, "", "PR_PHYSICAL_RENDITION_ATTRIBUTES"},
+	{0x0C110102, "", "PR_PROOF_OF_DELIVERY"},
+	{0x0C12000B, "", "PR_PROOF_OF_DELIVERY_REQUESTED"},
+	{0x0C130102, "", "PR_RECIPIENT_CERTIFICATE"},
+	{0x0C14001F, "", "PR_RECIPIENT_NUMBER_FOR_ADVICE"},
+	{0x0C150003, "PidTagRecipientType", "PR_RECIPIENT_TYPE"},
+	{0x0C160003, "", "PR_REGISTERED_MAIL_TYPE"},
+	{0x0C17000B, "PidTagReplyRequested", "PR_REPLY_REQUESTED"},
+	{0x0C180003, "", "PR_REQUESTED_DELIVERY_METHOD"},
+	{0x0C190102, "PidTagSenderEntryId", "PR_SENDER_ENTRYID"},
+	{0x0C1A001F, "PidTagSenderName", "PR_SENDER_NAME"},
+	{0x0C1B001F, "PidTagSupplementaryInfo", "PR_SUPPLEMENTARY_INFO"},
+	{0x0C1C0003, "", "PR_TYPE_OF_MTS_USER"},
+	{0x0C1D0102, "PidTagSenderSearchKey", "PR_SENDER_SEARCH_KEY"},
+	{0x0C1E001F, "PidTagSenderAddressType", "PR_SENDER_ADDRTYPE"},
+	{0x0C1F001F, "PidTagSenderEmailAddress", "PR_SENDER_EMAIL_ADDRESS"},
+	{0x0C200003, "PidTagNonDeliveryReportStatusCode", "PR_NDR_STATUS_CODE"},
+	{0x0C21001F, "PidTagRemoteMessageTransferAgent", "PR_DSN_REMOTE_MTA"},
+	{0x0E000014, "", "PR_CURRENT_VERSION"},
+	{0x0E01000B, "PidTagDeleteAfterSubmit", "PR_DELETE_AFTER_SUBMIT"},
+	{0x0E02001F, "PidTagDisplayBcc", "PR_DISPLAY_BCC"},
+	{0x0E03001F, "PidTagDisplayCc", "PR_DISPLAY_CC"},
+	{0x0E04001F, "PidTagDisplayTo", "PR_DISPLAY_TO"},
+	{0x0E05001F, "", "PR_PARENT_DISPLAY"},
+	{0x0E060040, "PidTagMessageDeliveryTime", "PR_MESSAGE_DELIVERY_TIME"},
+	{0x0E070003, "PidTagMessageFlags", "PR_MESSAGE_FLAGS"},
+	{0x0E080003, "PidTagMessageSize", "PR_MESSAGE_SIZE"},
+	{0x0E080014, "PidTagMessageSizeExtended", "PR_MESSAGE_SIZE_EXTENDED"},
+	{0x0E090102, "PidTagParentEntryId", "PR_PARENT_ENTRYID"},
+	{0x0E0A0102, "", "PR_SENTMAIL_ENTRYID"},
+	{0x0E0C000B, "", "PR_CORRELATE"},
+	{0x0E0D0102, "", "PR_CORRELATE_MTSID"},
+	{0x0E0E000B, "", "PR_DISCRETE_VALUES"},
+	{0x0E0F000B, "PidTagResponsibility", "PR_RESPONSIBILITY"},
+	{0x0E100003, "", "PR_SPOOLER_STATUS"},
+	{0x0E110003, "", "PR_TRANSPORT_STATUS"},
+	{0x0E12000D, "PidTagMessageRecipients", "PR_MESSAGE_RECIPIENTS"},
+	{0x0E13000D, "PidTagMessageAttachments", "PR_MESSAGE_ATTACHMENTS"},
+	{0x0E140003, "", "PR_SUBMIT_FLAGS"},
+	{0x0E150003, "", "PR_RECIPIENT_STATUS"},
+	{0x0E160003, "", "PR_TRANSPORT_KEY"},
+	{0x0E170003, "PidTagMessageStatus", "PR_MSG_STATUS"},
+	{0x0E180003, "", "PR_MESSAGE_DOWNLOAD_TIME"},
+	{0x0E190014, "", "PR_CREATION_VERSION"},
+	{0x0E1A0014, "", "PR_MODIFY_VERSION"},
+	{0x0E1B000B, "PidTagHasAttachments", "PR_HASATTACH"},
+	{0x0E1C0003, "", "PR_BODY_CRC"},
+	{0x0E1D001F, "PidTagNormalizedSubject", "PR_NORMALIZED_SUBJECT"},
+	{0x0E1F000B, "PidTagRtfInSync", "PR_RTF_IN_SYNC"},
+	{0x0E200003, "PidTagAttachSize", "PR_ATTACH_SIZE"},
+	{0x0E210003, "PidTagAttachNumber", "PR_ATTACH_NUM"},
+	{0x0E22000B, "", "PR_PREPROCESS"},
+	{0x0E230003, "PidTagInternetArticleNumber", "PR_INTERNET_ARTICLE_NUMBER"},
+	{0x0E250102, "", "PR_ORIGINATING_MTA_CERTIFICATE"},
+	{0x0E260102, "", "PR_PROOF_OF_SUBMISSION"},
+	{0x0E28001F, "PidTagPrimarySendAccount", ""},
+	{0x0E29001F, "PidTagNextSendAcct", ""},
+	{0x0E2B0003, "PidTagToDoItemFlags", ""},
+	{0x0E62000B, "PidTagUrlCompNameSet", "PR_URL_COMP_NAME_SET"},
+	{0x0E69000B, "PidTagRead", "PR_READ"},
+	{0x0E6A001F, "PidTagSecurityDescriptorAsXml", ""},
+	{0x0E790003, "PidTagTrustSender", ""},
+	{0x0E840102, "PidTagExchangeNTSecurityDescriptor", ""},
+	{0x0E990102, "PidTagExtendedRuleMessageActions", ""},
+	{0x0E9A0102, "PidTagExtendedRuleMessageCondition", ""},
+	{0x0E9B0003, "PidTagExtendedRuleSizeLimit", ""},
+	{0x0FF40003, "PidTagAccess", "PR_ACCESS"},
+	{0x0FF50003, "PidTagRowType", "PR_ROW_TYPE"},
+	{0x0FF60102, "PidTagInstanceKey", "PR_INSTANCE_KEY"},
+	{0x0FF70003, "PidTagAccessLevel", "PR_ACCESS_LEVEL"},
+	{0x0FF80102, "PidTagMappingSignature", "PR_MAPPING_SIGNATURE"},
+	{0x0FF90102, "PidTagRecordKey", "PR_RECORD_KEY"},
+	{0x0FFA0102, "PidTagStoreRecordKey", "PR_STORE_RECORD_KEY"},
+	{0x0FFB0102, "PidTagStoreEntryId", "PR_STORE_ENTRYID"},
+	{0x0FFC0102, "", "PR_MINI_ICON"},
+	{0x0FFD0102, "", "PR_ICON"},
+	{0x0FFE0003, "PidTagObjectType", "PR_OBJECT_TYPE"},
+	{0x0FFF0102, "PidTagEntryId", "PR_ENTRYID"},
+	{0x1000001F, "PidTagBody", "PR_BODY"},
+	{0x1001001F, "PidTagReportText", "PR_REPORT_TEXT"},
+	{0x10020102, "", "PR_ORIGINATOR_AND_DL_EXPANSION_HISTORY"},
+	{0x10030102, "", "PR_REPORTING_DL_NAME"},
+	{0x10040102, "", "PR_REPORTING_MTA_CERTIFICATE"},
+	{0x10060003, "PidTagRtfSyncBodyCrc", "PR_RTF_SYNC_BODY_CRC"},
+	{0x10070003, "PidTagRtfSyncBodyCount", "PR_RTF_SYNC_BODY_COUNT"},
+	{0x1008001F, "PidTagRtfSyncBodyTag", "PR_RTF_SYNC_BODY_TAG"},
+	{0x10090102, "PidTagRtfCompressed", "PR_RTF_COMPRESSED"},
+	{0x10100003, "PidTagRtfSyncPrefixCount", "PR_RTF_SYNC_PREFIX_COUNT"},
+	{0x10110003, "PidTagRtfSyncTrailingCount", "PR_RTF_SYNC_TRAILING_COUNT"},
+	{0x10120102, "", "PR_ORIGINALLY_INTENDED_RECIP_ENTRYID"},
+	{0x10130102, "PidTagHtml", "PR_HTML"},
+	{0x1013001F, "PidTagBodyHtml", "PR_BODY_HTML"},
+	{0x1014001F, "PidTagBodyContentLocation", "PR_BODY_CONTENT_LOCATION"},
+	{0x1015001F, "PidTagBodyContentId", "PR_BODY_CONTENT_ID"},
+	{0x10160003, "PidTagNativeBody", "PR_NATIVE_BODY_INFO"},
+	{0x1030001F, "PidTagInternetApproved", "PR_INTERNET_APPROVED"},
+	{0x1035001F, "PidTagInternetMessageId", "PR_INTERNET_MESSAGE_ID"},
+	{0x1039001F, "PidTagInternetReferences", "PR_INTERNET_REFERENCES"},
+	{0x1042001F, "PidTagInReplyToId", "PR_IN_REPLY_TO_ID"},
+	{0x1043001F, "PidTagListHelp", "PR_LIST_HELP"},
+	{0x1044001F, "PidTagListSubscribe", "PR_LIST_SUBSCRIBE"},
+	{0x1045001F, "PidTagListUnsubscribe", "PR_LIST_UNSUBSCRIBE"},
+	{0x1046001F, "PidTagOriginalMessageId", "PR_ORIGINAL_MESSAGE_ID"},
+	{0x10800003, "PidTagIconIndex", "PR_ICON_INDEX"},
+	{0x10810003, "PidTagLastVerbExecuted", "PR_LAST_VERB_EXECUTED"},
+	{0x10820040, "PidTagLastVerbExecutionTime", "PR_LAST_VERB_EXECUTION_TIME"},
+	{0x10900003, "PidTagFlagStatus", "PR_FLAG_STATUS"},
+	{0x10910040, "PidTagFlagCompleteTime", "PR_FLAG_COMPLETE_TIME"},
+	{0x10950003, "PidTagFollowupIcon", "PR_FOLLOWUP_ICON"},
+	{0x10960003, "PidTagBlockStatus", "PR_BLOCK_STATUS"},
+	{0x10C30040, "PidTagICalendarStartTime", ""},
+	{0x10C40040, "PidTagICalendarEndTime", ""},
+	{0x10C50040, "PidTagCdoRecurrenceid", ""},
+	{0x10CA0040, "PidTagICalendarReminderNextTime", ""},
+	{0x10F4000B, "PidTagAttributeHidden", "PR_ATTR_HIDDEN"},
+	{0x10F6000B, "PidTagAttributeReadOnly", "PR_ATTR_READONLY"},
+	{0x30000003, "PidTagRowid", "PR_ROWID"},
+	{0x3001001F, "PidTagDisplayName", "PR_DISPLAY_NAME"},
+	{0x3002001F, "PidTagAddressType", "PR_ADDRTYPE"},
+	{0x3003001F, "PidTagEmailAddress", "PR_EMAIL_ADDRESS"},
+	{0x3004001F, "PidTagComment", "PR_COMMENT"},
+	{0x30050003, "PidTagDepth", "PR_DEPTH"},
+	{0x3006001F, "", "PR_PROVIDER_DISPLAY"},
+	{0x30070040, "PidTagCreationTime", "PR_CREATION_TIME"},
+	{0x30080040, "PidTagLastModificationTime", "PR_LAST_MODIFICATION_TIME"},
+	{0x30090003, "", "PR_RESOURCE_FLAGS"},
+	{0x300A001E, "", "PR_PROVIDER_DLL_NAME"},
+	{0x300B0102, "PidTagSearchKey", "PR_SEARCH_KEY"},
+	{0x300C0102, "", "PR_PROVIDER_UID"},
+	{0x300D0003, "", "PR_PROVIDER_ORDINAL"},
+	{0x30100102, "PidTagTargetEntryId", ""},
+	{0x30130102, "PidTagConversationId", ""},
+	{0x3016000B, "PidTagConversationIndexTracking", ""},
+	{0x30180102, "PidTagArchiveTag", ""},
+	{0x30190102, "PidTagPolicyTag", ""},
+	{0x301A0003, "PidTagRetentionPeriod", ""},
+	{0x301C0040, "PidTagRetentionDate", ""},
+	{0x301D0003, "PidTagRetentionFlags", ""},
+	{0x301E0003, "PidTagArchivePeriod", ""},
+	{0x301F0040, "PidTagArchiveDate", ""},
+	{0x3301001F, "", "PR_FORM_VERSION"},
+	{0x33020048, "", "PR_FORM_CLSID"},
+	{0x3303001F, "", "PR_FORM_CONTACT_NAME"},
+	{0x3304001F, "", "PR_FORM_CATEGORY"},
+	{0x3305001F, "", "PR_FORM_CATEGORY_SUB"},
+	{0x33061003, "", "PR_FORM_HOST_MAP"},
+	{0x3307000B, "", "PR_FORM_HIDDEN"},
+	{0x3308001F, "", "PR_FORM_DESIGNER_NAME"},
+	{0x33090048, "", "PR_FORM_DESIGNER_GUID"},
+	{0x330A0003, "", "PR_FORM_MESSAGE_BEHAVIOR"},
+	{0x3400000B, "", "PR_DEFAULT_STORE"},
+	{0x340D0003, "PidTagStoreSupportMask", "PR_STORE_SUPPORT_MASK"},
+	{0x340E0003, "PidTagStoreState", "PR_STORE_STATE"},
+	{0x34100102, "", "PR_IPM_SUBTREE_SEARCH_KEY"},
+	{0x34110102, "", "PR_IPM_OUTBOX_SEARCH_KEY"},
+	{0x34120102, "", "PR_IPM_WASTEBASKET_SEARCH_KEY"},
+	{0x34130102, "", "PR_IPM_SENTMAIL_SEARCH_KEY"},
+	{0x34140102, "", "PR_MDB_PROVIDER"},
+	{0x3415000D, "", "PR_RECEIVE_FOLDER_SETTINGS"},
+	{0x35DF0003, "", "PR_VALID_FOLDER_MASK"},
+	{0x35E00102, "", "PR_IPM_SUBTREE_ENTRYID"},
+	{0x35E20102, "", "PR_IPM_OUTBOX_ENTRYID"},
+	{0x35E30102, "", "PR_IPM_WASTEBASKET_ENTRYID"},
+	{0x35E40102, "", "PR_IPM_SENTMAIL_ENTRYID"},
+	{0x35E50102, "", "PR_VIEWS_ENTRYID"},
+	{0x35E60102, "", "PR_COMMON_VIEWS_ENTRYID"},
+	{0x35E70102, "", "PR_FINDER_ENTRYID"},
+	{0x36000003, "PidTagContainerFlags", "PR_CONTAINER_FLAGS"},
+	{0x36010003, "PidTagFolderType", "PR_FOLDER_TYPE"},
+	{0x36020003, "PidTagContentCount", "PR_CONTENT_COUNT"},
+	{0x36030003, "PidTagContentUnreadCount", "PR_CONTENT_UNREAD"},
+	{0x3604000D, "", "PR_CREATE_TEMPLATES"},
+	{0x3605000D, "", "PR_DETAILS_TABLE"},
+	{0x3607000D, "", "PR_SEARCH"},
+	{0x3609000B, "PidTagSelectable", "PR_SELECTABLE"},
+	{0x360A000B, "PidTagSubfolders", "PR_SUBFOLDERS"},
+	{0x360B0003, "", "PR_STATUS"},
+	{0x360C001F, "PidTagAnr", "PR_ANR"},
+	{0x360D1003, "", "PR_CONTENTS_SORT_ORDER"},
+	{0x360E000D, "PidTagContainerHierarchy", "PR_CONTAINER_HIERARCHY"},
+	{0x360F000D, "PidTagContainerContents", "PR_CONTAINER_CONTENTS"},
+	{0x3610000D, "PidTagFolderAssociatedContents", "PR_FOLDER_ASSOCIATED_CONTENTS"},
+	{0x36110102, "", "PR_DEF_CREATE_DL"},
+	{0x36120102, "", "PR_DEF_CREATE_MAILUSER"},
+	{0x3613001F, "PidTagContainerClass", "PR_CONTAINER_CLASS"},
+	{0x36140014, "", "PR_CONTAINER_MODIFY_VERSION"},
+	{0x36150102, "", "PR_AB_PROVIDER_ID"},
+	{0x36160102, "", "PR_DEFAULT_VIEW_ENTRYID"},
+	{0x36170003, "PidTagAssociatedContentCount", "PR_ASSOC_CONTENT_COUNT"},
+	{0x37000102, "", "PR_ATTACHMENT_X400_PARAMETERS"},
+	{0x37010102, "PidTagAttachDataBinary", "PR_ATTACH_DATA_BIN"},
+	{0x3701000D, "PidTagAttachDataObject", "PR_ATTACH_DATA_OBJ"},
+	{0x37020102, "PidTagAttachEncoding", "PR_ATTACH_ENCODING"},
+	{0x3703001F, "PidTagAttachExtension", "PR_ATTACH_EXTENSION"},
+	{0x3704001F, "PidTagAttachFilename", "PR_ATTACH_FILENAME"},
+	{0x37050003, "PidTagAttachMethod", "PR_ATTACH_METHOD"},
+	{0x3707001F, "PidTagAttachLongFilename", "PR_ATTACH_LONG_FILENAME"},
+	{0x3708001F, "PidTagAttachPathname", "PR_ATTACH_PATHNAME"},
+	{0x37090102, "PidTagAttachRendering", "PR_ATTACH_RENDERING"},
+	{0x370A0102, "PidTagAttachTag", "PR_ATTACH_TAG"},
+	{0x370B0003, "PidTagRenderingPosition", "PR_RENDERING_POSITION"},
+	{0x370C001F, "PidTagAttachTransportName", "PR_ATTACH_TRANSPORT_NAME"},
+	{0x370D001F, "PidTagAttachLongPathname", "PR_ATTACH_LONG_PATHNAME"},
+	{0x370E001F, "PidTagAttachMimeTag", "PR_ATTACH_MIME_TAG"},
+	{0x370F0102, "PidTagAttachAdditionalInformation", "PR_ATTACH_ADDITIONAL_INFO"},
+	{0x3711001F, "PidTagAttachContentBase", "PR_ATTACH_CONTENT_BASE"},
+	{0x3712001F, "PidTagAttachContentId", "PR_ATTACH_CONTENT_ID"},
+	{0x3713001F, "PidTagAttachContentLocation", "PR_ATTACH_CONTENT_LOCATION"},
+	{0x37140003, "PidTagAttachFlags", "PR_ATTACH_FLAGS"},
+	{0x3719001F, "PidTagAttachPayloadProviderGuidString", ""},
+	{0x371A001F, "PidTagAttachPayloadClass", ""},
+	{0x371B001F, "PidTagTextAttachmentCharset", ""},
+	{0x39000003, "PidTagDisplayType", "PR_DISPLAY_TYPE"},
+	{0x39020102, "PidTagTemplateid", "PR_TEMPLATEID"},
+	{0x39040102, "", "PR_PRIMARY_CAPABILITY"},
+	{0x39050003, "PidTagDisplayTypeEx", "PR_DISPLAY_TYPE_EX"},
+	{0x39FE001F, "PidTagSmtpAddress", "PR_SMTP_ADDRESS"},
+	{0x39FF001F, "PidTagAddressBookDisplayNamePrintable", "PR_7BIT_DISPLAY_NAME"},
+	{0x3A00001F, "PidTagAccount", "PR_ACCOUNT"},
+	{0x3A010102, "", "PR_ALTERNATE_RECIPIENT"},
+	{0x3A02001F, "PidTagCallbackTelephoneNumber", "PR_CALLBACK_TELEPHONE_NUMBER"},
+	{0x3A03000B, "", "PR_CONVERSION_PROHIBITED"},
+	{0x3A04000B, "", "PR_DISCLOSE_RECIPIENTS"},
+	{0x3A05001F, "PidTagGeneration", "PR_GENERATION"},
+	{0x3A06001F, "PidTagGivenName", "PR_GIVEN_NAME"},
+	{0x3A07001F, "PidTagGovernmentIdNumber", "PR_GOVERNMENT_ID_NUMBER"},
+	{0x3A08001F, "PidTagBusinessTelephoneNumber", "PR_BUSINESS_TELEPHONE_NUMBER"},
+	{0x3A09001F, "PidTagHomeTelephoneNumber", "PR_HOME_TELEPHONE_NUMBER"},
+	{0x3A0A001F, "PidTagInitials", "PR_INITIALS"},
+	{0x3A0B001F, "PidTagKeyword", "PR_KEYWORD"},
+	{0x3A0C001F, "PidTagLanguage", "PR_LANGUAGE"},
+	{0x3A0D001F, "PidTagLocation", "PR_LOCATION"},
+	{0x3A0E000B, "", "PR_MAIL_PERMISSION"},
+	{0x3A0F001F, "PidTagMessageHandlingSystemCommonName", "PR_MHS_COMMON_NAME"},
+	{0x3A10001F, "PidTagOrganizationalIdNumber", "PR_ORGANIZATIONAL_ID_NUMBER"},
+	{0x3A11001F, "PidTagSurname", "PR_SURNAME"},
+	{0x3A120102, "PidTagOriginalEntryId", "PR_ORIGINAL_ENTRYID"},
+	{0x3A13001F, "", "PR_ORIGINAL_DISPLAY_NAME"},
+	{0x3A140102, "", "PR_ORIGINAL_SEARCH_KEY"},
+	{0x3A15001F, "PidTagPostalAddress", "PR_POSTAL_ADDRESS"},
+	{0x3A16001F, "PidTagCompanyName", "PR_COMPANY_NAME"},
+	{0x3A17001F, "PidTagTitle", "PR_TITLE"},
+	{0x3A18001F, "PidTagDepartmentName", "PR_DEPARTMENT_NAME"},
+	{0x3A19001F, "PidTagOfficeLocation", "PR_OFFICE_LOCATION"},
+	{0x3A1A001F, "PidTagPrimaryTelephoneNumber", "PR_PRIMARY_TELEPHONE_NUMBER"},
+	{0x3A1B001F, "PidTagBusiness2TelephoneNumber", "PR_BUSINESS2_TELEPHONE_NUMBER"},
+	{0x3A1B101F, "PidTagBusiness2TelephoneNumbers", ""},
+	{0x3A1C001F, "PidTagMobileTelephoneNumber", "PR_MOBILE_TELEPHONE_NUMBER"},
+	{0x3A1D001F, "PidTagRadioTelephoneNumber", "PR_RADIO_TELEPHONE_NUMBER"},
+	{0x3A1E001F, "PidTagCarTelephoneNumber", "PR_CAR_TELEPHONE_NUMBER"},
+	{0x3A1F001F, "PidTagOtherTelephoneNumber", "PR_OTHER_TELEPHONE_NUMBER"},
+	{0x3A20001F, "PidTagTransmittableDisplayName", "PR_TRANSMITABLE_DISPLAY_NAME"},
+	{0x3A21001F, "PidTagPagerTelephoneNumber", "PR_PAGER_TELEPHONE_NUMBER"},
+	{0x3A220102, "PidTagUserCertificate", "PR_USER_CERTIFICATE"},
+	{0x3A23001F, "PidTagPrimaryFaxNumber", "PR_PRIMARY_FAX_NUMBER"},
+	{0x3A24001F, "PidTagBusinessFaxNumber", "PR_BUSINESS_FAX_NUMBER"},
+	{0x3A25001F, "PidTagHomeFaxNumber", "PR_HOME_FAX_NUMBER"},
+	{0x3A26001F, "PidTagCountry", "PR_COUNTRY"},
+	{0x3A27001F, "PidTagLocality", "PR_LOCALITY"},
+	{0x3A28001F, "PidTagStateOrProvince", "PR_STATE_OR_PROVINCE"},
+	{0x3A29001F, "PidTagStreetAddress", "PR_STREET_ADDRESS"},
+	{0x3A2A001F, "PidTagPostalCode", "PR_POSTAL_CODE"},
+	{0x3A2B001F, "PidTagPostOfficeBox", "PR_POST_OFFICE_BOX"},
+	{0x3A2C001F, "PidTagTelexNumber", "PR_TELEX_NUMBER"},
+	{0x3A2D001F, "PidTagIsdnNumber", "PR_ISDN_NUMBER"},
+	{0x3A2E001F, "PidTagAssistantTelephoneNumber", "PR_ASSISTANT_TELEPHONE_NUMBER"},
+	{0x3A2F001F, "PidTagHome2TelephoneNumber", "PR_HOME2_TELEPHONE_NUMBER"},
+	{0x3A2F101F, "PidTagHome2TelephoneNumbers", ""},
+	{0x3A30001F, "PidTagAssistant", "PR_ASSISTANT"},
+	{0x3A40000B, "PidTagSendRichInfo", "PR_SEND_RICH_INFO"},
+	{0x3A410040, "PidTagWeddingAnniversary", "PR_WEDDING_ANNIVERSARY"},
+	{0x3A420040, "PidTagBirthday", "PR_BIRTHDAY"},
+	{0x3A43001F, "PidTagHobbies", "PR_HOBBIES"},
+	{0x3A44001F, "PidTagMiddleName", "PR_MIDDLE_NAME"},
+	{0x3A45001F, "PidTagDisplayNamePrefix", "PR_DISPLAY_NAME_PREFIX"},
+	{0x3A46001F, "PidTagProfession", "PR_PROFESSION"},
+	{0x3A47001F, "PidTagReferredByName", "PR_PREFERRED_BY_NAME"},
+	{0x3A48001F, "PidTagSpouseName", "PR_SPOUSE_NAME"},
+	{0x3A49001F, "PidTagComputerNetworkName", "PR_COMPUTER_NETWORK_NAME"},
+	{0x3A4A001F, "PidTagCustomerId", "PR_CUSTOMER_ID"},
+	{0x3A4B001F, "PidTagTelecommunicationsDeviceForDeafTelephoneNumber", "PR_TTYTDD_PHONE_NUMBER"},
+	{0x3A4C001F, "PidTagFtpSite", "PR_FTP_SITE"},
+	{0x3A4D0002, "PidTagGender", "PR_GENDER"},
+	{0x3A4E001F, "PidTagManagerName", "PR_MANAGER_NAME"},
+	{0x3A4F001F, "PidTagNickname", "PR_NICKNAME"},
+	{0x3A50001F, "PidTagPersonalHomePage", "PR_PERSONAL_HOME_PAGE"},
+	{0x3A51001F, "PidTagBusinessHomePage", "PR_BUSINESS_HOME_PAGE"},
+	{0x3A520048, "", "PR_CONTACT_VERSION"},
+	{0x3A531102, "", "PR_CONTACT_ENTRYIDS"},
+	{0x3A54101F, "", "PR_CONTACT_ADDRTYPES"},
+	{0x3A550003, "", "PR_CONTACT_DEFAULT_ADDRESS_INDEX"},
+	{0x3A56101F, "", "PR_CONTACT_EMAIL_ADDRESSES"},
+	{0x3A57001F, "PidTagCompanyMainTelephoneNumber", "PR_COMPANY_MAIN_PHONE_NUMBER"},
+	{0x3A58101F, "PidTagChildrensNames", "PR_CHILDRENS_NAMES"},
+	{0x3A59001F, "PidTagHomeAddressCity", "PR_HOME_ADDRESS_CITY"},
+	{0x3A5A001F, "PidTagHomeAddressCountry", "PR_HOME_ADDRESS_COUNTRY"},
+	{0x3A5B001F, "PidTagHomeAddressPostalCode", "PR_HOME_ADDRESS_POSTAL_CODE"},
+	{0x3A5C001F, "PidTagHomeAddressStateOrProvince", "PR_HOME_ADDRESS_STATE_OR_PROVINCE"},
+	{0x3A5D001F, "PidTagHomeAddressStreet", "PR_HOME_ADDRESS_STREET"},
+	{0x3A5E001F, "PidTagHomeAddressPostOfficeBox", "PR_HOME_ADDRESS_POST_OFFICE_BOX"},
+	{0x3A5F001F, "PidTagOtherAddressCity", "PR_OTHER_ADDRESS_CITY"},
+	{0x3A60001F, "PidTagOtherAddressCountry", "PR_OTHER_ADDRESS_COUNTRY"},
+	{0x3A61001F, "PidTagOtherAddressPostalCode", "PR_OTHER_ADDRESS_POSTAL_CODE"},
+	{0x3A62001F, "PidTagOtherAddressStateOrProvince", "PR_OTHER_ADDRESS_STATE_OR_PROVINCE"},
+	{0x3A63001F, "PidTagOtherAddressStreet", "PR_OTHER_ADDRESS_STREET"},
+	{0x3A64001F, "PidTagOtherAddressPostOfficeBox", "PR_OTHER_ADDRESS_POST_OFFICE_BOX"},
+	{0x3A701102, "PidTagUserX509Certificate", "PR_USER_X509_CERTIFICATE"},
+	{0x3A710003, "PidTagSendInternetEncoding", "PR_SEND_INTERNET_ENCODING"},
+	{0x3D000102, "", "PR_STORE_PROVIDERS"},
+	{0x3D010102, "", "PR_AB_PROVIDERS"},
+	{0x3D020102, "", "PR_TRANSPORT_PROVIDERS"},
+	{0x3D04000B, "", "PR_DEFAULT_PROFILE"},
+	{0x3D051102, "", "PR_AB_SEARCH_PATH"},
+	{0x3D060102, "", "PR_AB_DEFAULT_DIR"},
+	{0x3D070102, "", "PR_AB_DEFAULT_PAB"},
+	{0x3D080102, "", "PR_FILTERING_HOOKS"},
+	{0x3D09001F, "", "PR_SERVICE_NAME"},
+	{0x3D0A001F, "", "PR_SERVICE_DLL_NAME"},
+	{0x3D0B001E, "", "PR_SERVICE_ENTRY_NAME"},
+	{0x3D0C0102, "", "PR_SERVICE_UID"},
+	{0x3D0D0102, "", "PR_SERVICE_EXTRA_UIDS"},
+	{0x3D0E0102, "", "PR_SERVICES"},
+	{0x3D0F101F, "", "PR_SERVICE_SUPPORT_FILES"},
+	{0x3D10101F, "", "PR_SERVICE_DELETE_FILES"},
+	{0x3D110102, "", "PR_AB_SEARCH_PATH_UPDATE"},
+	{0x3D12001F, "", "PR_PROFILE_NAME"},
+	{0x3E00001F, "", "PR_IDENTITY_DISPLAY"},
+	{0x3E010102, "", "PR_IDENTITY_ENTRYID"},
+	{0x3E020003, "", "PR_RESOURCE_METHODS"},
+	{0x3E030003, "", "PR_RESOURCE_TYPE"},
+	{0x3E040003, "", "PR_STATUS_CODE"},
+	{0x3E050102, "", "PR_IDENTITY_SEARCH_KEY"},
+	{0x3E060102, "", "PR_OWN_STORE_ENTRYID"},
+	{0x3E07001F, "", "PR_RESOURCE_PATH"},
+	{0x3E08001F, "", "PR_STATUS_STRING"},
+	{0x3E09000B, "", "PR_X400_DEFERRED_DELIVERY_CANCEL"},
+	{0x3E0A0102, "", "PR_HEADER_FOLDER_ENTRYID"},
+	{0x3E0B0003, "", "PR_REMOTE_PROGRESS"},
+	{0x3E0C001F, "", "PR_REMOTE_PROGRESS_TEXT"},
+	{0x3E0D000B, "", "PR_REMOTE_VALIDATE_OK"},
+	{0x3F000003, "", "PR_CONTROL_FLAGS"},
+	{0x3F010102, "", "PR_CONTROL_STRUCTURE"},
+	{0x3F020003, "", "PR_CONTROL_TYPE"},
+	{0x3F030003, "", "PR_DELTAX"},
+	{0x3F040003, "", "PR_DELTAY"},
+	{0x3F050003, "", "PR_XPOS"},
+	{0x3F060003, "", "PR_YPOS"},
+	{0x3F070102, "", "PR_CONTROL_ID"},
+	{0x3F080003, "", "PR_INITIAL_DETAILS_PANE"},
+	{0x3FDE0003, "PidTagInternetCodepage", "PR_INTERNET_CPID"},
+	{0x3FDF0003, "PidTagAutoResponseSuppress", "PR_AUTO_RESPONSE_SUPPRESS"},
+	{0x3FE3000B, "PidTagDelegatedByRule", "PR_DELEGATED_BY_RULE"},
+	{0x3FE70003, "PidTagResolveMethod", "PR_RESOLVE_METHOD"},
+	{0x3FEA000B, "PidTagHasDeferredActionMessages", "PR_HAS_DAMS"},
+	{0x3FF10003, "PidTagMessageLocaleId", "PR_MESSAGE_LOCALE_ID"},
+	{0x3FF8001F, "PidTagCreatorName", "PR_CREATOR_NAME"},
+	{0x3FF90102, "PidTagCreatorEntryId", "PR_CREATOR_ENTRYID"},
+	{0x3FFA001F, "PidTagLastModifierName", "PR_LAST_MODIFIER_NAME"},
+	{0x3FFB0102, "PidTagLastModifierEntryId", "PR_LAST_MODIFIER_ENTRYID"},
+	{0x3FFD0003, "PidTagMessageCodepage", "PR_MESSAGE_CODEPAGE"},
+	{0x40190003, "PidTagSenderFlags", ""},
+	{0x401A0003, "PidTagSentRepresentingFlags", ""},
+	{0x4029001F, "PidTagReadReceiptAddressType", ""},
+	{0x402A001F, "PidTagReadReceiptEmailAddress", ""},
+	{0x402B001F, "PidTagReadReceiptName", ""},
+	{0x40760003, "PidTagContentFilterSpamConfidenceLevel", "PR_CONTENT_FILTER_SCL"},
+	{0x40790003, "PidTagSenderIdStatus", ""},
+	{0x4083001F, "PidTagPurportedSenderDomain", ""},
+	{0x59020003, "PidTagInternetMailOverrideFormat", ""},
+	{0x59090003, "PidTagMessageEditorFormat", "PR_MSG_EDITOR_FORMAT"},
+	{0x5D01001F, "PidTagSenderSmtpAddress", "PR_SENDER_SMTP_ADDRESS"},
+	{0x5D02001F, "PidTagSentRepresentingSmtpAddress", "PR_SENT_REPRESENTING_SMTP_ADDRESS"},
+	{0x5D05001F, "PidTagReadReceiptSmtpAddress", ""},
+	{0x5D07001F, "PidTagReceivedBySmtpAddress", ""},
+	{0x5D08001F, "PidTagReceivedRepresentingSmtpAddress", ""},
+	{0x5FDF0003, "PidTagRecipientOrder", ""},
+	{0x5FE1000B, "PidTagRecipientProposed", ""},
+	{0x5FE30040, "PidTagRecipientProposedStartTime", ""},
+	{0x5FE40040, "PidTagRecipientProposedEndTime", ""},
+	{0x5FF6001F, "PidTagRecipientDisplayName", ""},
+	{0x5FF70102, "PidTagRecipientEntryId", ""},
+	{0x5FFB0040, "PidTagRecipientTrackStatusTime", ""},
+	{0x5FFD0003, "PidTagRecipientFlags", ""},
+	{0x5FFF0003, "PidTagRecipientTrackStatus", ""},
+	{0x65E00102, "PidTagSourceKey", "PR_SOURCE_KEY"},
+	{0x65E10102, "PidTagParentSourceKey", "PR_PARENT_SOURCE_KEY"},
+	{0x65E20102, "PidTagChangeKey", "PR_CHANGE_KEY"},
+	{0x65E30102, "PidTagPredecessorChangeList", "PR_PREDECESSOR_CHANGE_LIST"},
+	{0x66190102, "PidTagUserEntryId", ""},
+	{0x66A10003, "PidTagLocaleId", "PR_LOCALE_ID"},
+	{0x67050003, "PidTagSortLocaleId", "PR_SORT_LOCALE_ID"},
+	{0x6707001F, "PidTagUrlName", "PR_URL_NAME"},
+	{0x6708000B, "PidTagSubfolder", ""},
+	{0x67090040, "PidTagLocalCommitTime", "PR_LOCAL_COMMIT_TIME"},
+	{0x670E001F, "PidTagFlatUrlName", "PR_FLAT_URL_NAME"},
+	{0x67480014, "PidTagFolderId", "PR_FID"},
+	{0x674A0014, "PidTagMid", "PR_MID"},
+	{0x67A40014, "PidTagChangeNumber", "PR_CHANGE_NUM"},
+	{0x67AA000B, "PidTagAssociated", "PR_ASSOCIATED"},
+	{0x7D01000B, "PidTagProcessed", ""},
+	{0x7FF90040, "PidTagExceptionReplaceTime", ""},
+	{0x7FFA0003, "PidTagAttachmentLinkId", ""},
+	{0x7FFB0040, "PidTagExceptionStartTime", ""},
+	{0x7FFC0040, "PidTagExceptionEndTime", ""},
+	{0x7FFD0003, "PidTagAttachmentFlags", ""},
+	{0x7FFE000B, "PidTagAttachmentHidden", ""},
+	{0x7FFF000B, "PidTagAttachmentContactPhoto", ""},
+}
+
+// namedProps lists the named properties with a numeric ID in [MS-OXPROPS].
+var namedProps = []namedPropInfo{
+	{NamedProp{PSETIDMeeting, 0x0001}, szmapiSystime, "PidLidAttendeeCriticalChange"},
+	{NamedProp{PSETIDMeeting, 0x0003}, szmapiBinary, "PidLidGlobalObjectId"},
+	{NamedProp{PSETIDMeeting, 0x0004}, szmapiBoolean, "PidLidIsRecurring"},
+	{NamedProp{PSETIDMeeting, 0x0006}, szmapiSystime, "PidLidOwnerCriticalChange"},
+	{NamedProp{PSETIDMeeting, 0x000A}, szmapiBoolean, "PidLidIsException"},
+	{NamedProp{PSETIDMeeting, 0x0023}, szmapiBinary, "PidLidCleanGlobalObjectId"},
+	{NamedProp{PSETIDMeeting, 0x0024}, szmapiUnicodeString, "PidLidAppointmentMessageClass"},
+	{NamedProp{PSETIDMeeting, 0x0026}, szmapiInt, "PidLidMeetingType"},
+	{NamedProp{PSETIDMeeting, 0x0028}, szmapiUnicodeString, "PidLidOldLocation"},
+	{NamedProp{PSETIDMeeting, 0x0029}, szmapiSystime, "PidLidOldWhenStartWhole"},
+	{NamedProp{PSETIDMeeting, 0x002A}, szmapiSystime, "PidLidOldWhenEndWhole"},
+	{NamedProp{PSETIDAddress, 0x8005}, szmapiUnicodeString, "PidLidFileUnder"},
+	{NamedProp{PSETIDAddress, 0x8006}, szmapiInt, "PidLidFileUnderId"},
+	{NamedProp{PSETIDAddress, 0x8007}, szmapiInt | mvFlag, "PidLidContactItemData"},
+	{NamedProp{PSETIDAddress, 0x8010}, szmapiUnicodeString, "PidLidDepartment"},
+	{NamedProp{PSETIDAddress, 0x8015}, szmapiBoolean, "PidLidHasPicture"},
+	{NamedProp{PSETIDAddress, 0x801A}, szmapiUnicodeString, "PidLidHomeAddress"},
+	{NamedProp{PSETIDAddress, 0x801B}, szmapiUnicodeString, "PidLidWorkAddress"},
+	{NamedProp{PSETIDAddress, 0x801C}, szmapiUnicodeString, "PidLidOtherAddress"},
+	{NamedProp{PSETIDAddress, 0x8022}, szmapiInt, "PidLidPostalAddressId"},
+	{NamedProp{PSETIDAddress, 0x8023}, szmapiInt, "PidLidContactCharacterSet"},
+	{NamedProp{PSETIDAddress, 0x8025}, szmapiBoolean, "PidLidAutoLog"},
+	{NamedProp{PSETIDAddress, 0x8026}, szmapiInt | mvFlag, "PidLidFileUnderList"},
+	{NamedProp{PSETIDAddress, 0x8028}, szmapiInt | mvFlag, "PidLidAddressBookProviderEmailList"},
+	{NamedProp{PSETIDAddress, 0x8029}, szmapiInt, "PidLidAddressBookProviderArrayType"},
+	{NamedProp{PSETIDAddress, 0x802B}, szmapiUnicodeString, "PidLidHtml"},
+	{NamedProp{PSETIDAddress, 0x802C}, szmapiUnicodeString, "PidLidYomiFirstName"},
+	{NamedProp{PSETIDAddress, 0x802D}, szmapiUnicodeString, "PidLidYomiLastName"},
+	{NamedProp{PSETIDAddress, 0x802E}, szmapiUnicodeString, "PidLidYomiCompanyName"},
+	{NamedProp{PSETIDAddress, 0x8040}, szmapiBinary, "PidLidBusinessCardDisplayDefinition"},
+	{NamedProp{PSETIDAddress, 0x8041}, szmapiBinary, "PidLidBusinessCardCardPicture"},
+	{NamedProp{PSETIDAddress, 0x8045}, szmapiUnicodeString, "PidLidWorkAddressStreet"},
+	{NamedProp{PSETIDAddress, 0x8046}, szmapiUnicodeString, "PidLidWorkAddressCity"},
+	{NamedProp{PSETIDAddress, 0x8047}, szmapiUnicodeString, "PidLidWorkAddressState"},
+	{NamedProp{PSETIDAddress, 0x8048}, szmapiUnicodeString, "PidLidWorkAddressPostalCode"},
+	{NamedProp{PSETIDAddress, 0x8049}, szmapiUnicodeString, "PidLidWorkAddressCountry"},
+	{NamedProp{PSETIDAddress, 0x804A}, szmapiUnicodeString, "PidLidWorkAddressPostOfficeBox"},
+	{NamedProp{PSETIDAddress, 0x804C}, szmapiInt, "PidLidDistributionListChecksum"},
+	{NamedProp{PSETIDAddress, 0x804D}, szmapiBinary, "PidLidBirthdayEventEntryId"},
+	{NamedProp{PSETIDAddress, 0x804E}, szmapiBinary, "PidLidAnniversaryEventEntryId"},
+	{NamedProp{PSETIDAddress, 0x804F}, szmapiUnicodeString, "PidLidContactUserField1"},
+	{NamedProp{PSETIDAddress, 0x8050}, szmapiUnicodeString, "PidLidContactUserField2"},
+	{NamedProp{PSETIDAddress, 0x8051}, szmapiUnicodeString, "PidLidContactUserField3"},
+	{NamedProp{PSETIDAddress, 0x8052}, szmapiUnicodeString, "PidLidContactUserField4"},
+	{NamedProp{PSETIDAddress, 0x8053}, szmapiUnicodeString, "PidLidDistributionListName"},
+	{NamedProp{PSETIDAddress, 0x8054}, szmapiBinary | mvFlag, "PidLidDistributionListOneOffMembers"},
+	{NamedProp{PSETIDAddress, 0x8055}, szmapiBinary | mvFlag, "PidLidDistributionListMembers"},
+	{NamedProp{PSETIDAddress, 0x8062}, szmapiUnicodeString, "PidLidInstantMessagingAddress"},
+	{NamedProp{PSETIDAddress, 0x8064}, szmapiBinary, "PidLidDistributionListStream"},
+	{NamedProp{PSETIDAddress, 0x8080}, szmapiUnicodeString, "PidLidEmail1DisplayName"},
+	{NamedProp{PSETIDAddress, 0x8082}, szmapiUnicodeString, "PidLidEmail1AddressType"},
+	{NamedProp{PSETIDAddress, 0x8083}, szmapiUnicodeString, "PidLidEmail1EmailAddress"},
+	{NamedProp{PSETIDAddress, 0x8084}, szmapiUnicodeString, "PidLidEmail1OriginalDisplayName"},
+	{NamedProp{PSETIDAddress, 0x8085}, szmapiBinary, "PidLidEmail1OriginalEntryId"},
+	{NamedProp{PSETIDAddress, 0x8090}, szmapiUnicodeString, "PidLidEmail2DisplayName"},
+	{NamedProp{PSETIDAddress, 0x8092}, szmapiUnicodeString, "PidLidEmail2AddressType"},
+	{NamedProp{PSETIDAddress, 0x8093}, szmapiUnicodeString, "PidLidEmail2EmailAddress"},
+	{NamedProp{PSETIDAddress, 0x8094}, szmapiUnicodeString, "PidLidEmail2OriginalDisplayName"},
+	{NamedProp{PSETIDAddress, 0x8095}, szmapiBinary, "PidLidEmail2OriginalEntryId"},
+	{NamedProp{PSETIDAddress, 0x80A0}, szmapiUnicodeString, "PidLidEmail3DisplayName"},
+	{NamedProp{PSETIDAddress, 0x80A2}, szmapiUnicodeString, "PidLidEmail3AddressType"},
+	{NamedProp{PSETIDAddress, 0x80A3}, szmapiUnicodeString, "PidLidEmail3EmailAddress"},
+	{NamedProp{PSETIDAddress, 0x80A4}, szmapiUnicodeString, "PidLidEmail3OriginalDisplayName"},
+	{NamedProp{PSETIDAddress, 0x80A5}, szmapiBinary, "PidLidEmail3OriginalEntryId"},
+	{NamedProp{PSETIDAddress, 0x80B2}, szmapiUnicodeString, "PidLidFax1AddressType"},
+	{NamedProp{PSETIDAddress, 0x80B3}, szmapiUnicodeString, "PidLidFax1EmailAddress"},
+	{NamedProp{PSETIDAddress, 0x80B4}, szmapiUnicodeString, "PidLidFax1OriginalDisplayName"},
+	{NamedProp{PSETIDAddress, 0x80B5}, szmapiBinary, "PidLidFax1OriginalEntryId"},
+	{NamedProp{PSETIDAddress, 0x80C2}, szmapiUnicodeString, "PidLidFax2AddressType"},
+	{NamedProp{PSETIDAddress, 0x80C3}, szmapiUnicodeString, "PidLidFax2EmailAddress"},
+	{NamedProp{PSETIDAddress, 0x80C4}, szmapiUnicodeString, "PidLidFax2OriginalDisplayName"},
+	{NamedProp{PSETIDAddress, 0x80C5}, szmapiBinary, "PidLidFax2OriginalEntryId"},
+	{NamedProp{PSETIDAddress, 0x80D2}, szmapiUnicodeString, "PidLidFax3AddressType"},
+	{NamedProp{PSETIDAddress, 0x80D3}, szmapiUnicodeString, "PidLidFax3EmailAddress"},
+	{NamedProp{PSETIDAddress, 0x80D4}, szmapiUnicodeString, "PidLidFax3OriginalDisplayName"},
+	{NamedProp{PSETIDAddress, 0x80D5}, szmapiBinary, "PidLidFax3OriginalEntryId"},
+	{NamedProp{PSETIDAddress, 0x80D8}, szmapiUnicodeString, "PidLidFreeBusyLocation"},
+	{NamedProp{PSETIDAddress, 0x80DA}, szmapiUnicodeString, "PidLidHomeAddressCountryCode"},
+	{NamedProp{PSETIDAddress, 0x80DB}, szmapiUnicodeString, "PidLidWorkAddressCountryCode"},
+	{NamedProp{PSETIDAddress, 0x80DC}, szmapiUnicodeString, "PidLidOtherAddressCountryCode"},
+	{NamedProp{PSETIDAddress, 0x80DD}, szmapiUnicodeString, "PidLidAddressCountryCode"},
+	{NamedProp{PSETIDAddress, 0x80DE}, szmapiSystime, "PidLidBirthdayLocal"},
+	{NamedProp{PSETIDAddress, 0x80DF}, szmapiSystime, "PidLidWeddingAnniversaryLocal"},
+	{NamedProp{PSETIDTask, 0x8101}, szmapiInt, "PidLidTaskStatus"},
+	{NamedProp{PSETIDTask, 0x8102}, szmapiDouble, "PidLidPercentComplete"},
+	{NamedProp{PSETIDTask, 0x8103}, szmapiBoolean, "PidLidTeamTask"},
+	{NamedProp{PSETIDTask, 0x8104}, szmapiSystime, "PidLidTaskStartDate"},
+	{NamedProp{PSETIDTask, 0x8105}, szmapiSystime, "PidLidTaskDueDate"},
+	{NamedProp{PSETIDTask, 0x8107}, szmapiBoolean, "PidLidTaskResetReminder"},
+	{NamedProp{PSETIDTask, 0x8108}, szmapiBoolean, "PidLidTaskAccepted"},
+	{NamedProp{PSETIDTask, 0x8109}, szmapiBoolean, "PidLidTaskDeadOccurrence"},
+	{NamedProp{PSETIDTask, 0x810F}, szmapiSystime, "PidLidTaskDateCompleted"},
+	{NamedProp{PSETIDTask, 0x8110}, szmapiInt, "PidLidTaskActualEffort"},
+	{NamedProp{PSETIDTask, 0x8111}, szmapiInt, "PidLidTaskEstimatedEffort"},
+	{NamedProp{PSETIDTask, 0x8112}, szmapiInt, "PidLidTaskVersion"},
+	{NamedProp{PSETIDTask, 0x8113}, szmapiInt, "PidLidTaskState"},
+	{NamedProp{PSETIDTask, 0x8115}, szmapiSystime, "PidLidTaskLastUpdate"},
+	{NamedProp{PSETIDTask, 0x8116}, szmapiBinary, "PidLidTaskRecurrence"},
+	{NamedProp{PSETIDTask, 0x8117}, szmapiBinary, "PidLidTaskAssigners"},
+	{NamedProp{PSETIDTask, 0x8119}, szmapiBoolean, "PidLidTaskStatusOnComplete"},
+	{NamedProp{PSETIDTask, 0x811A}, szmapiInt, "PidLidTaskHistory"},
+	{NamedProp{PSETIDTask, 0x811B}, szmapiBoolean, "PidLidTaskUpdates"},
+	{NamedProp{PSETIDTask, 0x811C}, szmapiBoolean, "PidLidTaskComplete"},
+	{NamedProp{PSETIDTask, 0x811E}, szmapiBoolean, "PidLidTaskFCreator"},
+	{NamedProp{PSETIDTask, 0x811F}, szmapiUnicodeString, "PidLidTaskOwner"},
+	{NamedProp{PSETIDTask, 0x8120}, szmapiInt, "PidLidTaskMultipleRecipients"},
+	{NamedProp{PSETIDTask, 0x8121}, szmapiUnicodeString, "PidLidTaskAssigner"},
+	{NamedProp{PSETIDTask, 0x8122}, szmapiUnicodeString, "PidLidTaskLastUser"},
+	{NamedProp{PSETIDTask, 0x8123}, szmapiInt, "PidLidTaskOrdinal"},
+	{NamedProp{PSETIDTask, 0x8124}, szmapiBoolean, "PidLidTaskNoCompute"},
+	{NamedProp{PSETIDTask, 0x8125}, szmapiUnicodeString, "PidLidTaskLastDelegate"},
+	{NamedProp{PSETIDTask, 0x8126}, szmapiBoolean, "PidLidTaskFRecurring"},
+	{NamedProp{PSETIDTask, 0x8127}, szmapiUnicodeString, "PidLidTaskRole"},
+	{NamedProp{PSETIDTask, 0x8129}, szmapiInt, "PidLidTaskOwnership"},
+	{NamedProp{PSETIDTask, 0x812A}, szmapiInt, "PidLidTaskAcceptanceState"},
+	{NamedProp{PSETIDTask, 0x812C}, szmapiBoolean, "PidLidTaskFFixOffline"},
+	{NamedProp{PSETIDTask, 0x8139}, szmapiInt, "PidLidTaskCustomFlags"},
+	{NamedProp{PSETIDAppointment, 0x8201}, szmapiInt, "PidLidAppointmentSequence"},
+	{NamedProp{PSETIDAppointment, 0x8202}, szmapiSystime, "PidLidAppointmentSequenceTime"},
+	{NamedProp{PSETIDAppointment, 0x8203}, szmapiInt, "PidLidAppointmentLastSequence"},
+	{NamedProp{PSETIDAppointment, 0x8204}, szmapiInt, "PidLidChangeHighlight"},
+	{NamedProp{PSETIDAppointment, 0x8205}, szmapiInt, "PidLidBusyStatus"},
+	{NamedProp{PSETIDAppointment, 0x8206}, szmapiBoolean, "PidLidFExceptionalBody"},
+	{NamedProp{PSETIDAppointment, 0x8207}, szmapiInt, "PidLidAppointmentAuxiliaryFlags"},
+	{NamedProp{PSETIDAppointment, 0x8208}, szmapiUnicodeString, "PidLidLocation"},
+	{NamedProp{PSETIDAppointment, 0x820A}, szmapiUnicodeString, "PidLidMeetingWorkspaceUrl"},
+	{NamedProp{PSETIDAppointment, 0x820B}, szmapiBoolean, "PidLidForwardInstance"},
+	{NamedProp{PSETIDAppointment, 0x820C}, szmapiBinary | mvFlag, "PidLidLinkedTaskItems"},
+	{NamedProp{PSETIDAppointment, 0x820D}, szmapiSystime, "PidLidAppointmentStartWhole"},
+	{NamedProp{PSETIDAppointment, 0x820E}, szmapiSystime, "PidLidAppointmentEndWhole"},
+	{NamedProp{PSETIDAppointment, 0x820F}, szmapiSystime, "PidLidAppointmentStartTime"},
+	{NamedProp{PSETIDAppointment, 0x8210}, szmapiSystime, "PidLidAppointmentEndTime"},
+	{NamedProp{PSETIDAppointment, 0x8211}, szmapiSystime, "PidLidAppointmentEndDate"},
+	{NamedProp{PSETIDAppointment, 0x8212}, szmapiSystime, "PidLidAppointmentStartDate"},
+	{NamedProp{PSETIDAppointment, 0x8213}, szmapiInt, "PidLidAppointmentDuration"},
+	{NamedProp{PSETIDAppointment, 0x8214}, szmapiInt, "PidLidAppointmentColor"},
+	{NamedProp{PSETIDAppointment, 0x8215}, szmapiBoolean, "PidLidAppointmentSubType"},
+	{NamedProp{PSETIDAppointment, 0x8216}, szmapiBinary, "PidLidAppointmentRecur"},
+	{NamedProp{PSETIDAppointment, 0x8217}, szmapiInt, "PidLidAppointmentStateFlags"},
+	{NamedProp{PSETIDAppointment, 0x8218}, szmapiInt, "PidLidResponseStatus"},
+	{NamedProp{PSETIDAppointment, 0x8220}, szmapiSystime, "PidLidAppointmentReplyTime"},
+	{NamedProp{PSETIDAppointment, 0x8223}, szmapiBoolean, "PidLidRecurring"},
+	{NamedProp{PSETIDAppointment, 0x8224}, szmapiInt, "PidLidIntendedBusyStatus"},
+	{NamedProp{PSETIDAppointment, 0x8226}, szmapiSystime, "PidLidAppointmentUpdateTime"},
+	{NamedProp{PSETIDAppointment, 0x8228}, szmapiSystime, "PidLidExceptionReplaceTime"},
+	{NamedProp{PSETIDAppointment, 0x8229}, szmapiBoolean, "PidLidFInvited"},
+	{NamedProp{PSETIDAppointment, 0x822B}, szmapiBoolean, "PidLidFExceptionalAttendees"},
+	{NamedProp{PSETIDAppointment, 0x822F}, szmapiUnicodeString, "PidLidOwnerName"},
+	{NamedProp{PSETIDAppointment, 0x8230}, szmapiBoolean, "PidLidFOthersAppointment"},
+	{NamedProp{PSETIDAppointment, 0x8231}, szmapiInt, "PidLidRecurrenceType"},
+	{NamedProp{PSETIDAppointment, 0x8232}, szmapiUnicodeString, "PidLidRecurrencePattern"},
+	{NamedProp{PSETIDAppointment, 0x8233}, szmapiBinary, "PidLidTimeZoneStruct"},
+	{NamedProp{PSETIDAppointment, 0x8234}, szmapiUnicodeString, "PidLidTimeZoneDescription"},
+	{NamedProp{PSETIDAppointment, 0x8235}, szmapiSystime, "PidLidClipStart"},
+	{NamedProp{PSETIDAppointment, 0x8236}, szmapiSystime, "PidLidClipEnd"},
+	{NamedProp{PSETIDAppointment, 0x8237}, szmapiBinary, "PidLidOriginalStoreEntryId"},
+	{NamedProp{PSETIDAppointment, 0x8238}, szmapiUnicodeString, "PidLidAllAttendeesString"},
+	{NamedProp{PSETIDAppointment, 0x823A}, szmapiBoolean, "PidLidAutoFillLocation"},
+	{NamedProp{PSETIDAppointment, 0x823B}, szmapiUnicodeString, "PidLidToAttendeesString"},
+	{NamedProp{PSETIDAppointment, 0x823C}, szmapiUnicodeString, "PidLidCcAttendeesString"},
+	{NamedProp{PSETIDAppointment, 0x8240}, szmapiBoolean, "PidLidConferencingCheck"},
+	{NamedProp{PSETIDAppointment, 0x8241}, szmapiInt, "PidLidConferencingType"},
+	{NamedProp{PSETIDAppointment, 0x8242}, szmapiUnicodeString, "PidLidDirectory"},
+	{NamedProp{PSETIDAppointment, 0x8243}, szmapiUnicodeString, "PidLidOrganizerAlias"},
+	{NamedProp{PSETIDAppointment, 0x8244}, szmapiBoolean, "PidLidAutoStartCheck"},
+	{NamedProp{PSETIDAppointment, 0x8246}, szmapiBoolean, "PidLidAllowExternalCheck"},
+	{NamedProp{PSETIDAppointment, 0x8247}, szmapiUnicodeString, "PidLidCollaborateDoc"},
+	{NamedProp{PSETIDAppointment, 0x8248}, szmapiUnicodeString, "PidLidNetShowUrl"},
+	{NamedProp{PSETIDAppointment, 0x8249}, szmapiUnicodeString, "PidLidOnlinePassword"},
+	{NamedProp{PSETIDAppointment, 0x8250}, szmapiSystime, "PidLidAppointmentProposedStartWhole"},
+	{NamedProp{PSETIDAppointment, 0x8251}, szmapiSystime, "PidLidAppointmentProposedEndWhole"},
+	{NamedProp{PSETIDAppointment, 0x8256}, szmapiInt, "PidLidAppointmentProposedDuration"},
+	{NamedProp{PSETIDAppointment, 0x8257}, szmapiBoolean, "PidLidAppointmentCounterProposal"},
+	{NamedProp{PSETIDAppointment, 0x8259}, szmapiInt, "PidLidAppointmentProposalNumber"},
+	{NamedProp{PSETIDAppointment, 0x825A}, szmapiBoolean, "PidLidAppointmentNotAllowPropose"},
+	{NamedProp{PSETIDAppointment, 0x825D}, szmapiBinary, "PidLidAppointmentUnsendableRecipients"},
+	{NamedProp{PSETIDAppointment, 0x825E}, szmapiBinary, "PidLidAppointmentTimeZoneDefinitionStartDisplay"},
+	{NamedProp{PSETIDAppointment, 0x825F}, szmapiBinary, "PidLidAppointmentTimeZoneDefinitionEndDisplay"},
+	{NamedProp{PSETIDAppointment, 0x8260}, szmapiBinary, "PidLidAppointmentTimeZoneDefinitionRecur"},
+	{NamedProp{PSETIDCommon, 0x8501}, szmapiInt, "PidLidReminderDelta"},
+	{NamedProp{PSETIDCommon, 0x8502}, szmapiSystime, "PidLidReminderTime"},
+	{NamedProp{PSETIDCommon, 0x8503}, szmapiBoolean, "PidLidReminderSet"},
+	{NamedProp{PSETIDCommon, 0x8506}, szmapiBoolean, "PidLidPrivate"},
+	{NamedProp{PSETIDCommon, 0x850E}, szmapiBoolean, "PidLidAgingDontAgeMe"},
+	{NamedProp{PSETIDCommon, 0x8510}, szmapiInt, "PidLidSideEffects"},
+	{NamedProp{PSETIDCommon, 0x8514}, szmapiBoolean, "PidLidSmartNoAttach"},
+	{NamedProp{PSETIDCommon, 0x8516}, szmapiSystime, "PidLidCommonStart"},
+	{NamedProp{PSETIDCommon, 0x8517}, szmapiSystime, "PidLidCommonEnd"},
+	{NamedProp{PSETIDCommon, 0x8518}, szmapiInt, "PidLidTaskMode"},
+	{NamedProp{PSETIDCommon, 0x8519}, szmapiBinary, "PidLidTaskGlobalId"},
+	{NamedProp{PSETIDCommon, 0x851C}, szmapiBoolean, "PidLidReminderOverride"},
+	{NamedProp{PSETIDCommon, 0x851F}, szmapiUnicodeString, "PidLidReminderFileParameter"},
+	{NamedProp{PSETIDCommon, 0x8520}, szmapiBinary, "PidLidVerbStream"},
+	{NamedProp{PSETIDCommon, 0x8524}, szmapiUnicodeString, "PidLidVerbResponse"},
+	{NamedProp{PSETIDCommon, 0x8530}, szmapiUnicodeString, "PidLidFlagRequest"},
+	{NamedProp{PSETIDCommon, 0x8535}, szmapiUnicodeString, "PidLidBilling"},
+	{NamedProp{PSETIDCommon, 0x8539}, szmapiUnicodeString | mvFlag, "PidLidCompanies"},
+	{NamedProp{PSETIDCommon, 0x853A}, szmapiUnicodeString | mvFlag, "PidLidContacts"},
+	{NamedProp{PSETIDCommon, 0x8552}, szmapiInt, "PidLidCurrentVersion"},
+	{NamedProp{PSETIDCommon, 0x8554}, szmapiUnicodeString, "PidLidCurrentVersionName"},
+	{NamedProp{PSETIDCommon, 0x8560}, szmapiSystime, "PidLidReminderSignalTime"},
+	{NamedProp{PSETIDCommon, 0x8580}, szmapiUnicodeString, "PidLidInternetAccountName"},
+	{NamedProp{PSETIDCommon, 0x8581}, szmapiUnicodeString, "PidLidInternetAccountStamp"},
+	{NamedProp{PSETIDCommon, 0x8582}, szmapiBoolean, "PidLidUseTnef"},
+	{NamedProp{PSETIDCommon, 0x85A0}, szmapiSystime, "PidLidToDoOrdinalDate"},
+	{NamedProp{PSETIDCommon, 0x85A1}, szmapiUnicodeString, "PidLidToDoSubOrdinal"},
+	{NamedProp{PSETIDCommon, 0x85A4}, szmapiUnicodeString, "PidLidToDoTitle"},
+	{NamedProp{PSETIDLog, 0x8700}, szmapiUnicodeString, "PidLidLogType"},
+	{NamedProp{PSETIDLog, 0x8706}, szmapiSystime, "PidLidLogStart"},
+	{NamedProp{PSETIDLog, 0x8707}, szmapiInt, "PidLidLogDuration"},
+	{NamedProp{PSETIDLog, 0x8708}, szmapiSystime, "PidLidLogEnd"},
+	{NamedProp{PSETIDNote, 0x8B00}, szmapiInt, "PidLidNoteColor"},
+	{NamedProp{PSETIDNote, 0x8B02}, szmapiInt, "PidLidNoteWidth"},
+	{NamedProp{PSETIDNote, 0x8B03}, szmapiInt, "PidLidNoteHeight"},
+	{NamedProp{PSETIDNote, 0x8B04}, szmapiInt, "PidLidNoteX"},
+	{NamedProp{PSETIDNote, 0x8B05}, szmapiInt, "PidLidNoteY"},
+}
@@ -0,0 +1,136 @@
+package tnef
+
+import "errors"
+
+// Recipient is a recipient of the message, from the recipient table
+// (ATTRECIPTABLE).
+type Recipient struct {
+	Address
+
+	// SMTPAddress is the SMTP address of the recipient; for SMTP addresses
+	// this is the same as Email, but Exchange ("EX") addresses may also
+	// have an SMTP address.
+	SMTPAddress string
+
+	Type        int // One of the Recipient* constants.
+	TrackStatus int // MAPIRecipientTrackStatus; one of the Response* constants.
+
+	// All MAPI properties of the recipient.
+	Attributes []MAPIAttribute
+}
+
+// Recipient types, as stored in Recipient.Type.
+const (
+	RecipientOriginator = 0x0000
+	RecipientTo         = 0x0001
+	RecipientCc         = 0x0002
+	RecipientBcc        = 0x0003
+)
+
+// Meeting responses, as stored in Recipient.TrackStatus.
+const (
+	ResponseNone         = 0x0000
+	ResponseOrganized    = 0x0001
+	ResponseTentative    = 0x0002
+	ResponseAccepted     = 0x0003
+	ResponseDeclined     = 0x0004
+	ResponseNotResponded = 0x0005
+)
+
+// decodeRecipients decodes the recipient table, which is a count followed by
+// a block of MAPI properties for every recipient. 8-bit strings are in the code
+// page cp.
+func decodeRecipients(obj tnefObject, d *Decoder, cp int) ([]Recipient, error) {
+	buf := &buffer{data: obj.Data, base: obj.Offset + objectHeaderSize, attr: obj.Name, dec: d}
+	n, err := buf.int(4)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every recipient takes up at least 4 bytes.
+	if n > buf.len()/4 {
+		return nil, buf.errorf("count is too large: %d", n)
+	}
+
+	recips := make([]Recipient, 0, n)
+	for i := 0; i < n; i++ {
+		attrs, err := decodeMapiBuf(buf)
+		if errors.Is(err, errUnknownType) {
+			// The rest of the table can't be decoded; keep what we have.
+			if err := buf.warn(err); err != nil {
+				return nil, err
+			}
+			setCodepage(attrs, cp)
+			return append(recips, newRecipient(attrs)), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		buf.attr = obj.Name
+		setCodepage(attrs, cp)
+		recips = append(recips, newRecipient(attrs))
+	}
+	return recips, nil
+}
+
+func newRecipient(attrs []MAPIAttribute) Recipient {
+	r := Recipient{Attributes: attrs}
+	for _, attr := range attrs {
+		switch attr.Name {
+		case MAPIDisplayName:
+			r.Name, _ = attr.StringValue()
+		case MAPIAddrtype:
+			r.AddressType, _ = attr.StringValue()
+		case MAPIEmailAddress:
+			r.Email, _ = attr.StringValue()
+		case MAPISmtpAddress:
+			r.SMTPAddress, _ = attr.StringValue()
+		case MAPIRecipientType:
+			r.Type, _ = attr.Int()
+		case MAPIRecipientTrackStatus:
+			r.TrackStatus, _ = attr.Int()
+		}
+	}
+
+	if r.SMTPAddress == "" && r.AddressType == "SMTP" {
+		r.SMTPAddress = r.Email
+	}
+	return r
+}
+
+// encodeRecipients encodes the recipient table, in the format read by
+// decodeRecipients.
+func encodeRecipients(recips []Recipient) []byte {
+	b := le32(uint32(len(recips)))
+	for _, r := range recips {
+		b = append(b, encodeMapi(recipientAttributes(r))...)
+	}
+	return b
+}
+
+// recipientAttributes gets the MAPI attributes to write for the recipient,
+// adding the typed fields if they're not in the attributes.
+func recipientAttributes(r Recipient) []MAPIAttribute {
+	has := make(map[int]bool)
+	for _, attr := range r.Attributes {
+		has[attr.Name] = true
+	}
+
+	attrs := r.Attributes
+	add := func(name int, value string) {
+		if !has[name] && value != "" {
+			attrs = append(attrs, MAPIAttribute{Type: szmapiUnicodeString, Name: name, Data: encodeUnicode(value)})
+		}
+	}
+	add(MAPIDisplayName, r.Name)
+	add(MAPIAddrtype, r.AddressType)
+	add(MAPIEmailAddress, r.Email)
+	add(MAPISmtpAddress, r.SMTPAddress)
+	if !has[MAPIRecipientType] {
+		attrs = append(attrs, MAPIAttribute{Type: szmapiInt, Name: MAPIRecipientType, Data: le32(uint32(r.Type))})
+	}
+	if !has[MAPIRecipientTrackStatus] && r.TrackStatus != ResponseNone {
+		attrs = append(attrs, MAPIAttribute{Type: szmapiInt, Name: MAPIRecipientTrackStatus, Data: le32(uint32(r.TrackStatus))})
+	}
+	return attrs
+}
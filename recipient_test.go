@@ -0,0 +1,70 @@
+package tnef
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/teamwork/test"
+)
+
+func TestRecipients(t *testing.T) {
+	d, err := Decode(test.Read(t, "./testdata", "body.tnef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(d.Recipients) != 1 {
+		t.Fatalf("wrong number of recipients: %d", len(d.Recipients))
+	}
+	r := d.Recipients[0]
+	want := Address{
+		Name:        "3kuser2",
+		AddressType: "EX",
+		Email:       "/O=BR-EXCH-TEST/OU=FIRST ADMINISTRATIVE GROUP/CN=RECIPIENTS/CN=3kuser2",
+	}
+	if r.Address != want {
+		t.Errorf("wrong Address: %#v", r.Address)
+	}
+	if r.SMTPAddress != "3kuser2@brexchange.dolphinsearch.com" {
+		t.Errorf("wrong SMTPAddress: %q", r.SMTPAddress)
+	}
+	if r.Type != RecipientTo {
+		t.Errorf("wrong Type: %d", r.Type)
+	}
+	if r.TrackStatus != ResponseNone {
+		t.Errorf("wrong TrackStatus: %d", r.TrackStatus)
+	}
+	if len(r.Attributes) != 15 {
+		t.Errorf("wrong number of attributes: %d", len(r.Attributes))
+	}
+}
+
+func TestEncodeRecipients(t *testing.T) {
+	in := &Data{Recipients: []Recipient{
+		{Address: Address{Name: "Alice", AddressType: "SMTP", Email: "alice@example.com"}, Type: RecipientTo},
+		{Address: Address{Name: "Bob", AddressType: "SMTP", Email: "bob@example.com"}, Type: RecipientCc,
+			TrackStatus: ResponseAccepted},
+	}}
+
+	buf := new(bytes.Buffer)
+	if err := Encode(buf, in); err != nil {
+		t.Fatal(err)
+	}
+	d, err := Decode(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(d.Recipients) != 2 {
+		t.Fatalf("wrong number of recipients: %d", len(d.Recipients))
+	}
+	for i, r := range d.Recipients {
+		want := in.Recipients[i]
+		want.SMTPAddress = want.Email
+		r.Attributes = nil
+		if !reflect.DeepEqual(r, want) {
+			t.Errorf("recipient %d\ngot:  %#v\nwant: %#v", i, r, want)
+		}
+	}
+}
@@ -0,0 +1,468 @@
+package tnef
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecurrencePattern is the schedule of a recurring appointment, as stored in
+// the PidLidAppointmentRecur property (see MS-OXOCAL 2.2.1.44).
+//
+// Dates and times are in the time zone of the appointment, but are stored as
+// UTC as the time zone isn't part of the pattern.
+type RecurrencePattern struct {
+	Frequency    int // One of the Recur* constants.
+	PatternType  int // One of the Pattern* constants.
+	CalendarType int // 0 for the Gregorian calendar.
+
+	// Period is the interval between occurrences: in minutes for daily
+	// recurrences, in weeks for weekly recurrences, and in months for
+	// monthly and yearly recurrences.
+	Period int
+
+	// DayMask is the days of the week for PatternWeek and PatternMonthNth,
+	// as a bitmask with Sunday as bit 0.
+	DayMask int
+
+	// DayOfMonth for PatternMonth and PatternMonthEnd.
+	DayOfMonth int
+
+	// Nth is the week of the month for PatternMonthNth, from 1 to 4, or 5
+	// for the last week.
+	Nth int
+
+	EndType         int // One of the End* constants.
+	OccurrenceCount int // For EndAfterCount.
+	FirstDayOfWeek  time.Weekday
+
+	// StartDate and EndDate are the dates of the first and last occurrence.
+	// EndDate is far in the future if the recurrence doesn't end.
+	StartDate time.Time
+	EndDate   time.Time
+
+	// Time of the day at which every occurrence starts and ends, relative to
+	// the start of the day.
+	StartTimeOffset time.Duration
+	EndTimeOffset   time.Duration
+
+	// DeletedInstances are the original dates of occurrences that were
+	// deleted or modified; ModifiedInstances are the new dates of the
+	// modified occurrences. Details of the modified occurrences are in
+	// Exceptions.
+	DeletedInstances  []time.Time
+	ModifiedInstances []time.Time
+	Exceptions        []RecurrenceException
+}
+
+// RecurrenceException is an occurrence of a recurring appointment that was
+// modified.
+type RecurrenceException struct {
+	Start         time.Time
+	End           time.Time
+	OriginalStart time.Time
+
+	// Subject and Location are set if they were changed for the occurrence.
+	Subject  string
+	Location string
+}
+
+// Recurrence frequencies, as stored in RecurrencePattern.Frequency.
+const (
+	RecurDaily   = 0x200A
+	RecurWeekly  = 0x200B
+	RecurMonthly = 0x200C
+	RecurYearly  = 0x200D
+)
+
+// Recurrence pattern types, as stored in RecurrencePattern.PatternType.
+const (
+	PatternDay      = 0x0000
+	PatternWeek     = 0x0001
+	PatternMonth    = 0x0002
+	PatternMonthNth = 0x0003
+	PatternMonthEnd = 0x0004
+)
+
+// Recurrence end types, as stored in RecurrencePattern.EndType.
+const (
+	EndAfterDate  = 0x2021
+	EndAfterCount = 0x2022
+	EndNever      = 0x2023
+)
+
+// Overrides in the exception info.
+const (
+	aroSubject      = 0x0001
+	aroMeetingType  = 0x0002
+	aroReminderDel  = 0x0004
+	aroReminder     = 0x0008
+	aroLocation     = 0x0010
+	aroBusyStatus   = 0x0020
+	aroAttachment   = 0x0040
+	aroSubType      = 0x0080
+	aroAppointColor = 0x0100
+)
+
+// DecodeRecurrence decodes the recurrence pattern from the
+// PidLidAppointmentRecur property (Appointment.Recurrence).
+//
+// The subject and location of exceptions are taken from the Unicode strings
+// in the extended exceptions. If those aren't present the 8-bit strings are
+// used, which are assumed to be UTF-8 or Windows-1252 as the code page of the
+// message isn't known here.
+func DecodeRecurrence(data []byte) (*RecurrencePattern, error) {
+	return decodeRecurrence(data, 0)
+}
+
+// decodeRecurrence decodes a recurrence pattern; 8-bit strings are in the code
+// page cp.
+func decodeRecurrence(data []byte, cp int) (*RecurrencePattern, error) {
+	buf := &buffer{data: data, attr: lidAppointmentRecur}
+	r := &RecurrencePattern{}
+
+	var err error
+	read := func(n int) int {
+		if err != nil {
+			return 0
+		}
+		var v int
+		v, err = buf.int(n)
+		return v
+	}
+
+	read(4) // Reader and writer version.
+	r.Frequency = read(2)
+	r.PatternType = read(2)
+	r.CalendarType = read(2)
+	read(4) // FirstDateTime; only used to calculate the next occurrence.
+	r.Period = read(4)
+	read(4) // SlidingFlag; only used for tasks.
+	if err != nil {
+		return nil, err
+	}
+
+	switch r.PatternType {
+	case PatternDay:
+	case PatternWeek:
+		r.DayMask = read(4)
+	case PatternMonth, PatternMonthEnd:
+		r.DayOfMonth = read(4)
+	case PatternMonthNth:
+		r.DayMask = read(4)
+		r.Nth = read(4)
+	default:
+		return nil, buf.errorf("unsupported recurrence pattern type 0x%04x", r.PatternType)
+	}
+
+	r.EndType = read(4)
+	if r.EndType == 0xffffffff {
+		r.EndType = EndNever
+	}
+	r.OccurrenceCount = read(4)
+	r.FirstDayOfWeek = time.Weekday(read(4) % 7)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.DeletedInstances, err = recurrenceDates(buf); err != nil {
+		return nil, err
+	}
+	if r.ModifiedInstances, err = recurrenceDates(buf); err != nil {
+		return nil, err
+	}
+	if r.StartDate, err = recurrenceTime(buf); err != nil {
+		return nil, err
+	}
+	if r.EndDate, err = recurrenceTime(buf); err != nil {
+		return nil, err
+	}
+
+	// The rest is only in an AppointmentRecurrencePattern, as opposed to
+	// the RecurrencePattern used for tasks.
+	if buf.len() == 0 {
+		return r, nil
+	}
+
+	read(4) // Reader version.
+	writerVersion := read(4)
+	r.StartTimeOffset = time.Duration(read(4)) * time.Minute
+	r.EndTimeOffset = time.Duration(read(4)) * time.Minute
+	n := read(2)
+	if err != nil {
+		return nil, err
+	}
+	flags := make([]int, n)
+	for i := 0; i < n; i++ {
+		e, f, err := decodeException(buf, cp)
+		if err != nil {
+			return nil, err
+		}
+		r.Exceptions = append(r.Exceptions, e)
+		flags[i] = f
+	}
+
+	// Followed by the extended exceptions with the Unicode subject and
+	// location. Older versions of Outlook don't write them, so they're
+	// optional.
+	decodeExtendedExceptions(buf, r.Exceptions, flags, writerVersion)
+	return r, nil
+}
+
+// decodeExtendedExceptions sets the subject and location of the exceptions
+// from the Unicode strings in the extended exceptions, if they're present and
+// valid; flags are the overrides of every exception.
+func decodeExtendedExceptions(buf *buffer, exceptions []RecurrenceException, flags []int, writerVersion int) {
+	skipBlock := func() bool {
+		n, err := buf.int(4)
+		return err == nil && buf.skip(n) == nil
+	}
+	wideString := func() (string, bool) {
+		n, err := buf.int(2)
+		if err != nil {
+			return "", false
+		}
+		b, err := buf.bytes(n * 2)
+		if err != nil {
+			return "", false
+		}
+		return decodeUTF16(b), true
+	}
+
+	if !skipBlock() { // ReservedBlock1
+		return
+	}
+	ext := make([]RecurrenceException, len(exceptions))
+	copy(ext, exceptions)
+	for i := range ext {
+		// The change highlight is only written by Outlook 2003 and later.
+		if writerVersion >= 0x3009 && !skipBlock() {
+			return
+		}
+		if !skipBlock() { // ReservedBlockEE1
+			return
+		}
+		if flags[i]&(aroSubject|aroLocation) == 0 {
+			continue
+		}
+		// Start, end, and original start, which are the same as in the
+		// exception.
+		if buf.skip(12) != nil {
+			return
+		}
+		var ok bool
+		if flags[i]&aroSubject != 0 {
+			if ext[i].Subject, ok = wideString(); !ok {
+				return
+			}
+		}
+		if flags[i]&aroLocation != 0 {
+			if ext[i].Location, ok = wideString(); !ok {
+				return
+			}
+		}
+		if !skipBlock() { // ReservedBlockEE2
+			return
+		}
+	}
+	copy(exceptions, ext)
+}
+
+// recurrenceTime reads a date and time in the recurrence pattern, which is
+// stored as the number of minutes since 1601-01-01.
+func recurrenceTime(buf *buffer) (time.Time, error) {
+	m, err := buf.int(4)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(1601, 1, 1, 0, m, 0, 0, time.UTC), nil
+}
+
+// recurrenceDates reads a list of dates preceded by the count.
+func recurrenceDates(buf *buffer) ([]time.Time, error) {
+	n, err := buf.int(4)
+	if err != nil {
+		return nil, err
+	}
+	if n > buf.len()/4 {
+		return nil, buf.errorf("count is too large: %d", n)
+	}
+
+	dates := make([]time.Time, 0, n)
+	for i := 0; i < n; i++ {
+		t, err := recurrenceTime(buf)
+		if err != nil {
+			return nil, err
+		}
+		dates = append(dates, t)
+	}
+	return dates, nil
+}
+
+// decodeException decodes an ExceptionInfo, with 8-bit strings in the code
+// page cp. It also returns the flags of the overridden values.
+func decodeException(buf *buffer, cp int) (RecurrenceException, int, error) {
+	var e RecurrenceException
+	var err error
+	for _, t := range []*time.Time{&e.Start, &e.End, &e.OriginalStart} {
+		if *t, err = recurrenceTime(buf); err != nil {
+			return e, 0, err
+		}
+	}
+
+	flags, err := buf.int(2)
+	if err != nil {
+		return e, 0, err
+	}
+
+	// The overridden values are in the order of the flags.
+	for _, f := range []int{aroSubject, aroMeetingType, aroReminderDel, aroReminder, aroLocation,
+		aroBusyStatus, aroAttachment, aroSubType, aroAppointColor} {
+		if flags&f == 0 {
+			continue
+		}
+
+		switch f {
+		case aroSubject, aroLocation:
+			// Length including the length field, and the length of the
+			// string.
+			if err := buf.skip(2); err != nil {
+				return e, 0, err
+			}
+			var n int
+			if n, err = buf.int(2); err != nil {
+				return e, 0, err
+			}
+			var s []byte
+			if s, err = buf.bytes(n); err != nil {
+				return e, 0, err
+			}
+			if f == aroSubject {
+				e.Subject = decodeCodepage(cp, s)
+			} else {
+				e.Location = decodeCodepage(cp, s)
+			}
+		default:
+			if err := buf.skip(4); err != nil {
+				return e, 0, err
+			}
+		}
+	}
+	return e, flags, nil
+}
+
+// ErrUnsupportedRecurrence signals that a recurrence pattern can't be
+// converted to an iCalendar RRULE, e.g. because it uses the Hijri calendar.
+var ErrUnsupportedRecurrence = errors.New("unsupported recurrence pattern")
+
+var icalWeekdays = [7]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// RRule converts the recurrence pattern to an iCalendar (RFC 5545) RRULE
+// value, and the EXDATEs of the occurrences that were deleted.
+//
+// start is the start of the first occurrence, which is used to convert the
+// local times in the pattern to UTC; this doesn't take daylight saving time in
+// to account.
+func (r *RecurrencePattern) RRule(start time.Time) (rule string, exdates []time.Time, err error) {
+	return r.rrule(start, false)
+}
+
+// rrule is like RRule, but writes UNTIL as a date for events with a DATE as
+// DTSTART, as required by RFC 5545.
+func (r *RecurrencePattern) rrule(start time.Time, allDay bool) (rule string, exdates []time.Time, err error) {
+	if r.CalendarType != 0 {
+		return "", nil, fmt.Errorf("%w: calendar type %d", ErrUnsupportedRecurrence, r.CalendarType)
+	}
+
+	var parts []string
+	add := func(k, v string) { parts = append(parts, k+"="+v) }
+	interval := r.Period
+
+	switch {
+	case r.Frequency == RecurDaily && r.PatternType == PatternWeek:
+		// Every weekday.
+		add("FREQ", "WEEKLY")
+	case r.Frequency == RecurDaily:
+		add("FREQ", "DAILY")
+		interval /= 24 * 60
+	case r.Frequency == RecurWeekly:
+		add("FREQ", "WEEKLY")
+	case r.Frequency == RecurMonthly:
+		add("FREQ", "MONTHLY")
+	case r.Frequency == RecurYearly:
+		add("FREQ", "YEARLY")
+		interval /= 12
+		add("BYMONTH", strconv.Itoa(int(r.StartDate.Month())))
+	default:
+		return "", nil, fmt.Errorf("%w: frequency 0x%04x", ErrUnsupportedRecurrence, r.Frequency)
+	}
+	if interval > 1 {
+		add("INTERVAL", strconv.Itoa(interval))
+	}
+
+	switch r.PatternType {
+	case PatternWeek:
+		add("BYDAY", r.weekdays())
+	case PatternMonth:
+		add("BYMONTHDAY", strconv.Itoa(r.DayOfMonth))
+	case PatternMonthEnd:
+		add("BYMONTHDAY", "-1")
+	case PatternMonthNth:
+		add("BYDAY", r.weekdays())
+		if r.Nth == 5 {
+			add("BYSETPOS", "-1")
+		} else {
+			add("BYSETPOS", strconv.Itoa(r.Nth))
+		}
+	}
+
+	offset := r.utcOffset(start)
+	toUTC := func(t time.Time) time.Time { return t.Add(-offset) }
+
+	switch r.EndType {
+	case EndAfterDate:
+		if allDay {
+			add("UNTIL", r.EndDate.Format(icalDateFormat))
+		} else {
+			add("UNTIL", icalTime(toUTC(r.EndDate.Add(r.StartTimeOffset))))
+		}
+	case EndAfterCount:
+		add("COUNT", strconv.Itoa(r.OccurrenceCount))
+	}
+	if r.FirstDayOfWeek != time.Monday && (r.PatternType == PatternWeek || r.PatternType == PatternMonthNth) {
+		add("WKST", icalWeekdays[r.FirstDayOfWeek])
+	}
+
+	// Modified occurrences are also deleted, but are added back with
+	// RECURRENCE-ID.
+	modified := make(map[time.Time]bool)
+	for _, e := range r.Exceptions {
+		modified[e.OriginalStart] = true
+	}
+	for _, d := range r.DeletedInstances {
+		t := d.Add(r.StartTimeOffset)
+		if !modified[t] {
+			exdates = append(exdates, toUTC(t))
+		}
+	}
+
+	return strings.Join(parts, ";"), exdates, nil
+}
+
+// utcOffset gets the offset between the local times in the pattern and UTC,
+// from the start of the first occurrence in UTC.
+func (r *RecurrencePattern) utcOffset(start time.Time) time.Duration {
+	return r.StartDate.Add(r.StartTimeOffset).Sub(start)
+}
+
+func (r *RecurrencePattern) weekdays() string {
+	var days []string
+	for i, d := range icalWeekdays {
+		if r.DayMask&(1<<uint(i)) != 0 {
+			days = append(days, d)
+		}
+	}
+	return strings.Join(days, ",")
+}
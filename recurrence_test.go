@@ -0,0 +1,267 @@
+package tnef
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf16"
+)
+
+// recurrenceMinutes gets t as minutes since 1601-01-01.
+func recurrenceMinutes(t time.Time) []byte {
+	return le32(uint32((t.Unix() + filetimeUnixOffset) / 60))
+}
+
+func date(y, m, d, h, min int) time.Time {
+	return time.Date(y, time.Month(m), d, h, min, 0, 0, time.UTC)
+}
+
+// Every two weeks on Tuesday and Thursday at 9:00 in UTC+1, with one
+// occurrence deleted and one moved.
+func testRecurrence() []byte {
+	var b []byte
+	add := func(v ...[]byte) {
+		for _, x := range v {
+			b = append(b, x...)
+		}
+	}
+
+	add(le16(0x3004), le16(0x3004), le16(RecurWeekly), le16(PatternWeek), le16(0))
+	add(le32(0), le32(2), le32(0))
+	add(le32(0x04 | 0x10)) // Tuesday and Thursday.
+	add(le32(EndAfterDate), le32(10), le32(1))
+	add(le32(2), recurrenceMinutes(date(2020, 1, 9, 0, 0)), recurrenceMinutes(date(2020, 1, 21, 0, 0)))
+	add(le32(1), recurrenceMinutes(date(2020, 1, 22, 0, 0)))
+	add(recurrenceMinutes(date(2020, 1, 7, 0, 0)), recurrenceMinutes(date(2020, 2, 27, 0, 0)))
+
+	add(le32(0x3006), le32(0x3009), le32(9*60), le32(10*60))
+	add(le16(1))
+	add(recurrenceMinutes(date(2020, 1, 22, 14, 0)), recurrenceMinutes(date(2020, 1, 22, 15, 0)),
+		recurrenceMinutes(date(2020, 1, 21, 9, 0)))
+	add(le16(aroSubject | aroBusyStatus))
+	add(le16(6), le16(5), []byte("Moved"))
+	add(le32(BusyBusy))
+	add(le32(0)) // Reserved block.
+	return b
+}
+
+func TestDecodeRecurrence(t *testing.T) {
+	got, err := DecodeRecurrence(testRecurrence())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &RecurrencePattern{
+		Frequency:         RecurWeekly,
+		PatternType:       PatternWeek,
+		Period:            2,
+		DayMask:           0x14,
+		EndType:           EndAfterDate,
+		OccurrenceCount:   10,
+		FirstDayOfWeek:    time.Monday,
+		StartDate:         date(2020, 1, 7, 0, 0),
+		EndDate:           date(2020, 2, 27, 0, 0),
+		StartTimeOffset:   9 * time.Hour,
+		EndTimeOffset:     10 * time.Hour,
+		DeletedInstances:  []time.Time{date(2020, 1, 9, 0, 0), date(2020, 1, 21, 0, 0)},
+		ModifiedInstances: []time.Time{date(2020, 1, 22, 0, 0)},
+		Exceptions: []RecurrenceException{{
+			Start:         date(2020, 1, 22, 14, 0),
+			End:           date(2020, 1, 22, 15, 0),
+			OriginalStart: date(2020, 1, 21, 9, 0),
+			Subject:       "Moved",
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	// The pattern without the appointment-specific part (66 bytes) is valid;
+	// any other truncation before the reserved block is an error.
+	data := testRecurrence()
+	for i := 0; i < len(data)-4; i++ {
+		_, err := DecodeRecurrence(data[:i])
+		if i == 66 && err != nil {
+			t.Errorf("error for the pattern without the appointment part: %v", err)
+		}
+		if i != 66 && err == nil {
+			t.Errorf("no error for data truncated at %d", i)
+		}
+	}
+}
+
+func TestDecodeRecurrenceStrings(t *testing.T) {
+	// The 8-bit subject is in the code page of the message.
+	data := bytes.Replace(testRecurrence(), []byte("Moved"), []byte{0xcf, 0xe5, 0xf0, 0xe5, 0xed}, 1)
+	r, err := decodeRecurrence(data, 1251)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Exceptions[0].Subject; got != "Перен" {
+		t.Errorf("wrong Subject: %q", got)
+	}
+
+	// The Unicode subject from the extended exception is used if it's
+	// there.
+	subject := utf16.Encode([]rune("Déplacé"))
+	data = testRecurrence()
+	for _, v := range [][]byte{
+		le32(4), le32(0), // Change highlight.
+		le32(0), // ReservedBlockEE1.
+		recurrenceMinutes(date(2020, 1, 22, 14, 0)), recurrenceMinutes(date(2020, 1, 22, 15, 0)),
+		recurrenceMinutes(date(2020, 1, 21, 9, 0)),
+		le16(uint16(len(subject))),
+	} {
+		data = append(data, v...)
+	}
+	for _, c := range subject {
+		data = append(data, le16(c)...)
+	}
+	data = append(data, le32(0)...) // ReservedBlockEE2.
+	data = append(data, le32(0)...) // ReservedBlock2.
+
+	r, err = DecodeRecurrence(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Exceptions[0].Subject; got != "Déplacé" {
+		t.Errorf("wrong Subject: %q", got)
+	}
+
+	// Truncated extended exceptions are ignored.
+	r, err = DecodeRecurrence(data[:len(data)-12])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Exceptions[0].Subject; got != "Moved" {
+		t.Errorf("wrong Subject: %q", got)
+	}
+}
+
+func TestRRule(t *testing.T) {
+	tests := []struct {
+		in          RecurrencePattern
+		want        string
+		wantExdates []time.Time
+		wantErr     error
+	}{
+		{
+			RecurrencePattern{Frequency: RecurDaily, PatternType: PatternDay, Period: 3 * 24 * 60,
+				EndType: EndAfterCount, OccurrenceCount: 5},
+			"FREQ=DAILY;INTERVAL=3;COUNT=5", nil, nil,
+		},
+		{
+			RecurrencePattern{Frequency: RecurDaily, PatternType: PatternWeek, Period: 1, DayMask: 0x3e,
+				EndType: EndNever, FirstDayOfWeek: time.Sunday},
+			"FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR;WKST=SU", nil, nil,
+		},
+		{
+			RecurrencePattern{Frequency: RecurMonthly, PatternType: PatternMonthNth, Period: 1, DayMask: 0x02,
+				Nth: 5, EndType: EndNever, FirstDayOfWeek: time.Monday},
+			"FREQ=MONTHLY;BYDAY=MO;BYSETPOS=-1", nil, nil,
+		},
+		{
+			RecurrencePattern{Frequency: RecurMonthly, PatternType: PatternMonthEnd, Period: 2, EndType: EndNever},
+			"FREQ=MONTHLY;INTERVAL=2;BYMONTHDAY=-1", nil, nil,
+		},
+		{
+			RecurrencePattern{Frequency: RecurYearly, PatternType: PatternMonth, Period: 12, DayOfMonth: 7,
+				EndType: EndNever, StartDate: date(2020, 1, 7, 0, 0)},
+			"FREQ=YEARLY;BYMONTH=1;BYMONTHDAY=7", nil, nil,
+		},
+		{
+			RecurrencePattern{Frequency: RecurMonthly, PatternType: PatternMonth, CalendarType: 6},
+			"", nil, ErrUnsupportedRecurrence,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, exdates, err := tt.in.RRule(tt.in.StartDate)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("wrong error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+			if !reflect.DeepEqual(exdates, tt.wantExdates) {
+				t.Errorf("wrong exdates: %v", exdates)
+			}
+		})
+	}
+}
+
+func TestWriteICalendarRecurring(t *testing.T) {
+	a := &Appointment{
+		Method:     "PUBLISH",
+		UID:        "ABC",
+		Subject:    "Standup",
+		Start:      date(2020, 1, 7, 8, 0),
+		End:        date(2020, 1, 7, 9, 0),
+		BusyStatus: BusyBusy,
+		SentAt:     date(2020, 1, 1, 0, 0),
+		Recurring:  true,
+		Recurrence: testRecurrence(),
+	}
+
+	buf := new(bytes.Buffer)
+	if err := a.WriteICalendar(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := strings.Replace(`BEGIN:VCALENDAR
+PRODID:-//Teamwork//tnef//EN
+VERSION:2.0
+METHOD:PUBLISH
+BEGIN:VEVENT
+UID:ABC
+DTSTAMP:20200101T000000Z
+SEQUENCE:0
+DTSTART:20200107T080000Z
+DTEND:20200107T090000Z
+RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;UNTIL=20200227T080000Z
+EXDATE:20200109T080000Z
+SUMMARY:Standup
+TRANSP:OPAQUE
+X-MICROSOFT-CDO-BUSYSTATUS:BUSY
+END:VEVENT
+BEGIN:VEVENT
+UID:ABC
+DTSTAMP:20200101T000000Z
+SEQUENCE:0
+DTSTART:20200122T130000Z
+DTEND:20200122T140000Z
+RECURRENCE-ID:20200121T080000Z
+SUMMARY:Moved
+TRANSP:OPAQUE
+X-MICROSOFT-CDO-BUSYSTATUS:BUSY
+END:VEVENT
+END:VCALENDAR
+`, "\n", "\r\n", -1)
+	if got := buf.String(); got != want {
+		t.Errorf("\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Non-Gregorian calendar; written without the RRULE.
+	a.Recurrence = testRecurrence()
+	a.Recurrence[8] = 6
+	buf.Reset()
+	if err := a.WriteICalendar(buf); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); strings.Contains(got, "RRULE") || strings.Contains(got, "RECURRENCE-ID") ||
+		!strings.Contains(got, "DTSTART:20200107T080000Z") {
+		t.Errorf("wrong output:\n%s", got)
+	}
+	if len(a.Warnings) != 1 || !errors.Is(a.Warnings[0], ErrUnsupportedRecurrence) {
+		t.Errorf("wrong warnings: %v", a.Warnings)
+	}
+
+	a.Recurrence = a.Recurrence[:20]
+	if err := a.WriteICalendar(new(bytes.Buffer)); err == nil {
+		t.Error("no error for invalid recurrence")
+	}
+}
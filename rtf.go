@@ -0,0 +1,115 @@
+package tnef
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+)
+
+// Compression types for compressed RTF; see MS-OXRTFCP.
+const (
+	rtfCompressed   = 0x75465a4c // "LZFu"
+	rtfUncompressed = 0x414c454d // "MELA"
+)
+
+// The dictionary used by the compressed RTF format is initialized with this
+// string.
+const rtfPrebuf = "{\\rtf1\\ansi\\mac\\deff0\\deftab720{\\fonttbl;}{\\f0\\fnil \\froman " +
+	"\\fswiss \\fmodern \\fscript \\fdecor MS Sans SerifSymbolArialTimes New RomanCourier" +
+	"{\\colortbl\\red0\\green0\\blue0\r\n\\par \\pard\\plain\\f0\\fs20\\b\\i\\u\\tab\\tx"
+
+// ErrCompressedRTF signals that the compressed RTF data is invalid.
+var ErrCompressedRTF = errors.New("invalid compressed RTF")
+
+// DecompressRTF decompresses the RTF body stored in the PR_RTF_COMPRESSED
+// (MAPIRtfCompressed) property, as described in MS-OXRTFCP.
+//
+// Both the compressed ("LZFu") and uncompressed ("MELA") formats are
+// supported. The CRC of compressed data is verified.
+func DecompressRTF(data []byte) ([]byte, error) {
+	if len(data) < 16 {
+		return nil, fmt.Errorf("%w: header too short", ErrCompressedRTF)
+	}
+
+	compSize := byteToInt(data[0:4])
+	rawSize := byteToInt(data[4:8])
+	compType := byteToInt(data[8:12])
+	crc := uint32(byteToInt(data[12:16]))
+
+	// The compressed size doesn't include the compSize field itself.
+	if compSize < 12 || compSize+4 > len(data) {
+		return nil, fmt.Errorf("%w: compressed size %d doesn't match data length %d",
+			ErrCompressedRTF, compSize, len(data))
+	}
+	data = data[16 : compSize+4]
+
+	switch compType {
+	case rtfUncompressed:
+		if rawSize > len(data) {
+			return nil, fmt.Errorf("%w: raw size %d larger than data", ErrCompressedRTF, rawSize)
+		}
+		return data[:rawSize], nil
+	case rtfCompressed:
+		// Same table as CRC-32, but without the inversion at the start and
+		// end.
+		if got := ^crc32.Update(^uint32(0), crc32.IEEETable, data); got != crc {
+			return nil, fmt.Errorf("%w: CRC mismatch: got 0x%08x, want 0x%08x", ErrCompressedRTF, got, crc)
+		}
+		return decompressLZFu(data, rawSize)
+	default:
+		return nil, fmt.Errorf("%w: unknown compression type 0x%08x", ErrCompressedRTF, compType)
+	}
+}
+
+func decompressLZFu(data []byte, rawSize int) ([]byte, error) {
+	const dictSize = 4096
+
+	var dict [dictSize]byte
+	copy(dict[:], rtfPrebuf)
+	writePos := len(rtfPrebuf)
+
+	// rawSize comes from the data, so don't trust it too much for the
+	// initial allocation.
+	if rawSize > len(data)*8 {
+		rawSize = len(data) * 8
+	}
+	out := make([]byte, 0, rawSize)
+	put := func(b byte) {
+		out = append(out, b)
+		dict[writePos] = b
+		writePos = (writePos + 1) % dictSize
+	}
+
+	for i := 0; i < len(data); {
+		control := data[i]
+		i++
+
+		for bit := uint(0); bit < 8 && i < len(data); bit++ {
+			// Literal byte.
+			if control&(1<<bit) == 0 {
+				put(data[i])
+				i++
+				continue
+			}
+
+			// Reference to the dictionary: 12 bits offset, 4 bits length.
+			if i+2 > len(data) {
+				return nil, fmt.Errorf("%w: truncated dictionary reference", ErrCompressedRTF)
+			}
+			ref := int(data[i])<<8 | int(data[i+1])
+			i += 2
+
+			offset, length := ref>>4, ref&0xf+2
+			if offset == writePos {
+				return out, nil
+			}
+			for j := 0; j < length; j++ {
+				put(dict[(offset+j)%dictSize])
+			}
+		}
+	}
+
+	// Should always end with a reference to the write position, but some
+	// writers don't bother.
+	return out, nil
+}
@@ -0,0 +1,149 @@
+package tnef
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/teamwork/test"
+)
+
+func TestDecompressRTF(t *testing.T) {
+	tests := []struct {
+		in      []byte
+		want    string
+		wantErr error
+	}{
+		// Examples from MS-OXRTFCP section 3.
+		{[]byte{
+			0x2d, 0x00, 0x00, 0x00, 0x2b, 0x00, 0x00, 0x00, 0x4c, 0x5a, 0x46, 0x75, 0xf1, 0xc5, 0xc7, 0xa7,
+			0x03, 0x00, 0x0a, 0x00, 0x72, 0x63, 0x70, 0x67, 0x31, 0x32, 0x35, 0x42, 0x32, 0x0a, 0xf3, 0x20,
+			0x68, 0x65, 0x6c, 0x09, 0x00, 0x20, 0x62, 0x77, 0x05, 0xb0, 0x6c, 0x64, 0x7d, 0x0a, 0x80, 0x0f,
+			0xa0,
+		}, "{\\rtf1\\ansi\\ansicpg1252\\pard hello world}\r\n", nil},
+		{[]byte{
+			0x1a, 0x00, 0x00, 0x00, 0x1c, 0x00, 0x00, 0x00, 0x4c, 0x5a, 0x46, 0x75, 0xe2, 0xd4, 0x4b, 0x51,
+			0x41, 0x00, 0x04, 0x20, 0x57, 0x58, 0x59, 0x5a, 0x0d, 0x6e, 0x7d, 0x01, 0x0e, 0xb0,
+		}, "{\\rtf1 WXYZWXYZWXYZWXYZWXYZ}", nil},
+		{[]byte("\x0f\x00\x00\x00\x03\x00\x00\x00MELA\x00\x00\x00\x00abc"), "abc", nil},
+
+		{[]byte("short"), "", ErrCompressedRTF},
+		{[]byte("\xff\x00\x00\x00\x03\x00\x00\x00MELA\x00\x00\x00\x00abc"), "", ErrCompressedRTF},
+		{[]byte("\x0f\x00\x00\x00\x03\x00\x00\x00XXXX\x00\x00\x00\x00abc"), "", ErrCompressedRTF},
+		{[]byte("\x0f\x00\x00\x00\x03\x00\x00\x00LZFu\x00\x00\x00\x00abc"), "", ErrCompressedRTF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, err := DecompressRTF(tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("wrong err\ngot:  %v\nwant: %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("\ngot:  %q\nwant: %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBodyRTF(t *testing.T) {
+	for _, in := range []string{"rtf", "triples", "long-filename", "missing-filenames", "data-before-name"} {
+		t.Run(in, func(t *testing.T) {
+			out, err := DecodeWithOptions(test.Read(t, "./testdata", in+".tnef"), DecodeOptions{Strict: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.HasPrefix(out.BodyRTF, []byte("{\\rtf1")) {
+				t.Errorf("BodyRTF doesn't look like RTF: %q", out.BodyRTF)
+			}
+		})
+	}
+}
+
+func TestDecapsulateRTF(t *testing.T) {
+	tests := []struct {
+		in       string
+		want     string
+		wantHTML bool
+		wantErr  error
+	}{
+		// Based on the example in MS-OXRTFEX section 3.
+		{`{\rtf1\ansi\ansicpg1251\fromhtml1 \deff0{\fonttbl
+{\f0\fswiss\fcharset204 Arial;}
+{\f1\fmodern Courier New;}
+{\f2\fnil\fcharset2 Symbol;}
+{\f3\fmodern\fcharset0 Courier New;}}
+{\colortbl\red0\green0\blue0;\red0\green0\blue255;}
+\uc1\pard\plain\deftab360 \f0\fs24
+{\*\htmltag19 <html>}
+{\*\htmltag2 \par }
+{\*\htmltag34 <head>}
+{\*\htmltag1 \par }
+{\*\htmltag41 </head>}
+{\*\htmltag50 <body>}
+\htmlrtf {\htmlrtf0 {\*\htmltag148 <b>}\htmlrtf {\b \htmlrtf0 Hello\htmlrtf }\htmlrtf0 {\*\htmltag156 </b>}\htmlrtf }\htmlrtf0
+{\*\htmltag58 </body>}
+{\*\htmltag27 </html>}}`,
+			"<html>\r\n<head>\r\n</head><body><b>Hello</b></body></html>", true, nil},
+		{`{\rtf1\ansi\ansicpg1252\fromtext \deff0{\fonttbl {\f0\fswiss Arial;}}
+\uc1\pard\plain\deftab360 \f0\fs20 caf\'e9 \u8364?\par
+\{x\}\tab y\par
+}`,
+			"café €\r\n{x}\ty\r\n", false, nil},
+		// Unknown code page; the escapes are valid UTF-8 but it's still
+		// Windows-1252.
+		{`{\rtf1\ansi\ansicpg0\fromtext caf\'c3\'a9\par}`, "cafÃ©\r\n", false, nil},
+		// Surrogate pair; a high surrogate on its own is invalid.
+		{`{\rtf1\ansi\fromtext \u-10179?\u-8704?\par}`, "😀\r\n", false, nil},
+		{`{\rtf1\ansi\fromtext \u-10179?x\u-8704?}`, "\uFFFDx\uFFFD", false, nil},
+
+		{`{\rtf1\ansi\ansicpg1252\deff0{\fonttbl{\f0\fswiss\fcharset0 Arial;}} Hello\par}`,
+			"", false, ErrNotEncapsulated},
+		{`{\rtf1\ansi\fromhtml1 \'zz}`, "", false, errors.New("invalid \\' escape in RTF")},
+		{`{\rtf1\ansi\fromhtml1 x\`, "", false, errors.New("RTF ends with a backslash")},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			got, isHTML, err := DecapsulateRTF([]byte(tt.in))
+			if !test.ErrorContains(err, errString(tt.wantErr)) {
+				t.Fatalf("wrong err\ngot:  %v\nwant: %v", err, tt.wantErr)
+			}
+			var fmtErr *FormatError
+			if tt.wantErr != nil && tt.wantErr != ErrNotEncapsulated && !errors.As(err, &fmtErr) {
+				t.Errorf("not a *FormatError: %#v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("\ngot:  %q\nwant: %q", got, tt.want)
+			}
+			if isHTML != tt.wantHTML {
+				t.Errorf("isHTML is %t", isHTML)
+			}
+		})
+	}
+}
+
+func TestDecodeEncapsulated(t *testing.T) {
+	out, err := Decode(test.Read(t, "./testdata", "multi-value-attribute.tnef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(out.BodyHTML, []byte("<html><head>")) {
+		t.Errorf("wrong BodyHTML: %q", out.BodyHTML)
+	}
+
+	out, err = Decode(test.Read(t, "./testdata", "long-filename.tnef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(out.Body, []byte("I've attached a temp. license")) {
+		t.Errorf("wrong Body: %q", out.Body)
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,382 @@
+package tnef
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// ErrNotEncapsulated signals that RTF doesn't contain encapsulated HTML or
+// plain text, i.e. it was not generated from HTML or plain text by Outlook.
+var ErrNotEncapsulated = errors.New("RTF does not contain encapsulated HTML or text")
+
+// DecapsulateRTF recovers the original HTML or plain text from RTF that was
+// generated from it (marked with \fromhtml1 or \fromtext), as described in
+// MS-OXRTFEX. isHTML is true if the body is HTML.
+//
+// The body is returned as UTF-8. ErrNotEncapsulated is returned if rtf is
+// "real" RTF that wasn't generated from HTML or text, and a *FormatError if
+// it's malformed; its Offset is the offset in rtf.
+func DecapsulateRTF(rtf []byte) (body []byte, isHTML bool, err error) {
+	if !bytes.HasPrefix(rtf, []byte(`{\rtf1`)) {
+		return nil, false, &FormatError{Attr: MAPIRtfCompressed, Msg: "not an RTF document"}
+	}
+
+	d := &rtfDecap{
+		t:        rtfTokenizer{data: rtf},
+		codepage: 1252,
+	}
+	isHTML, ok := d.encapsulated()
+	if !ok {
+		return nil, false, ErrNotEncapsulated
+	}
+	d.html = isHTML
+
+	if err := d.run(); err != nil {
+		return nil, false, err
+	}
+	return d.out.Bytes(), isHTML, nil
+}
+
+// RTF token types.
+const (
+	rtfText       = iota // Literal text (one byte).
+	rtfGroupStart        // {
+	rtfGroupEnd          // }
+	rtfControl           // \word or \wordN
+	rtfSymbol            // \ followed by a non-letter
+	rtfHex               // \'hh
+	rtfEOF
+)
+
+type rtfToken struct {
+	kind     int
+	word     string // Control word or symbol.
+	param    int
+	hasParam bool
+	b        byte // For rtfText and rtfHex.
+}
+
+type rtfTokenizer struct {
+	data []byte
+	pos  int
+}
+
+func (t *rtfTokenizer) next() (rtfToken, error) {
+	for t.pos < len(t.data) {
+		c := t.data[t.pos]
+		t.pos++
+		switch c {
+		case '{':
+			return rtfToken{kind: rtfGroupStart}, nil
+		case '}':
+			return rtfToken{kind: rtfGroupEnd}, nil
+		case '\r', '\n':
+			// Line breaks in the RTF source are not part of the text.
+			continue
+		case '\\':
+			return t.control()
+		default:
+			return rtfToken{kind: rtfText, b: c}, nil
+		}
+	}
+	return rtfToken{kind: rtfEOF}, nil
+}
+
+func (t *rtfTokenizer) control() (rtfToken, error) {
+	if t.pos >= len(t.data) {
+		return rtfToken{}, t.errorf("RTF ends with a backslash")
+	}
+
+	c := t.data[t.pos]
+	if !isLetter(c) {
+		t.pos++
+		if c == '\'' {
+			if t.pos+2 > len(t.data) {
+				return rtfToken{}, t.errorf("RTF ends in the middle of a \\' escape")
+			}
+			n, err := strconv.ParseUint(string(t.data[t.pos:t.pos+2]), 16, 8)
+			if err != nil {
+				return rtfToken{}, t.errorf("invalid \\' escape in RTF")
+			}
+			t.pos += 2
+			return rtfToken{kind: rtfHex, b: byte(n)}, nil
+		}
+		return rtfToken{kind: rtfSymbol, word: string(c)}, nil
+	}
+
+	start := t.pos
+	for t.pos < len(t.data) && isLetter(t.data[t.pos]) {
+		t.pos++
+	}
+	tok := rtfToken{kind: rtfControl, word: string(t.data[start:t.pos])}
+
+	start = t.pos
+	if t.pos < len(t.data) && t.data[t.pos] == '-' {
+		t.pos++
+	}
+	for t.pos < len(t.data) && t.data[t.pos] >= '0' && t.data[t.pos] <= '9' {
+		t.pos++
+	}
+	if t.pos > start {
+		n, err := strconv.Atoi(string(t.data[start:t.pos]))
+		if err != nil {
+			return rtfToken{}, t.errorf("invalid parameter for \\%s in RTF", tok.word)
+		}
+		tok.param, tok.hasParam = n, true
+	}
+
+	// A space after a control word is part of the control word.
+	if t.pos < len(t.data) && t.data[t.pos] == ' ' {
+		t.pos++
+	}
+	return tok, nil
+}
+
+// errorf creates a FormatError at the current position.
+func (t *rtfTokenizer) errorf(format string, a ...interface{}) error {
+	return &FormatError{Offset: int64(t.pos), Attr: MAPIRtfCompressed, Msg: fmt.Sprintf(format, a...)}
+}
+
+func isLetter(c byte) bool { return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') }
+
+// Destinations which contain no body text.
+var rtfSkipDestinations = map[string]bool{
+	"fonttbl":      true,
+	"colortbl":     true,
+	"stylesheet":   true,
+	"info":         true,
+	"pict":         true,
+	"object":       true,
+	"header":       true,
+	"footer":       true,
+	"listtable":    true,
+	"themedata":    true,
+	"datastore":    true,
+	"xmlnstbl":     true,
+	"latentstyles": true,
+}
+
+// Control words that produce text in the body.
+var rtfControlText = map[string]string{
+	"par":       "\r\n",
+	"line":      "\r\n",
+	"tab":       "\t",
+	"lquote":    "‘",
+	"rquote":    "’",
+	"ldblquote": "“",
+	"rdblquote": "”",
+	"bullet":    "•",
+	"endash":    "–",
+	"emdash":    "—",
+}
+
+type rtfGroup struct {
+	skip    bool // Ignore all content.
+	htmlrtf bool // In a \htmlrtf block; ignore content outside of \htmltag.
+	htmltag bool // In a \htmltag destination.
+	uc      int  // Number of fallback characters after \u.
+}
+
+type rtfDecap struct {
+	t        rtfTokenizer
+	html     bool
+	codepage int
+	out      bytes.Buffer
+
+	// Bytes from \'hh escapes and text, which are in the codepage and need
+	// to be converted to UTF-8.
+	pending []byte
+
+	// High surrogate from a \u control word, combined with the next \u.
+	surrogate rune
+}
+
+// encapsulated looks for \fromhtml1 or \fromtext in the RTF header.
+func (d *rtfDecap) encapsulated() (isHTML bool, ok bool) {
+	t := d.t
+	depth := 0
+	for i := 0; i < 10; {
+		tok, err := t.next()
+		if err != nil {
+			return false, false
+		}
+		switch tok.kind {
+		case rtfEOF, rtfText:
+			return false, false
+		case rtfGroupStart:
+			depth++
+		case rtfGroupEnd:
+			depth--
+		case rtfControl:
+			if depth != 1 {
+				continue
+			}
+			i++
+			switch tok.word {
+			case "fromhtml":
+				return true, tok.param == 1
+			case "fromtext":
+				return false, true
+			}
+		}
+	}
+	return false, false
+}
+
+func (d *rtfDecap) run() error {
+	stack := []rtfGroup{{uc: 1}}
+	skipFallback := 0 // Characters to skip after \u.
+	groupStart := false
+
+	for {
+		tok, err := d.t.next()
+		if err != nil {
+			return err
+		}
+		g := &stack[len(stack)-1]
+
+		// The first control word in a group can start a destination.
+		if groupStart {
+			groupStart = false
+			if tok.kind == rtfSymbol && tok.word == "*" {
+				tok, err = d.t.next()
+				if err != nil {
+					return err
+				}
+				if tok.kind == rtfControl && tok.word == "htmltag" && d.html {
+					g.htmltag = true
+				} else {
+					g.skip = true
+				}
+				continue
+			}
+			if tok.kind == rtfControl && rtfSkipDestinations[tok.word] {
+				g.skip = true
+				continue
+			}
+		}
+
+		switch tok.kind {
+		case rtfEOF:
+			d.flush()
+			return nil
+
+		case rtfGroupStart:
+			d.flush()
+			stack = append(stack, *g)
+			groupStart = true
+			continue
+
+		case rtfGroupEnd:
+			d.flush()
+			if len(stack) == 1 {
+				return nil
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		output := !g.skip && (g.htmltag || !g.htmlrtf)
+		switch tok.kind {
+		case rtfText, rtfHex:
+			if skipFallback > 0 {
+				skipFallback--
+				continue
+			}
+			if output {
+				d.pending = append(d.pending, tok.b)
+			}
+
+		case rtfSymbol:
+			if skipFallback > 0 {
+				skipFallback--
+				continue
+			}
+			if !output {
+				continue
+			}
+			switch tok.word {
+			case "\\", "{", "}":
+				d.pending = append(d.pending, tok.word[0])
+			case "~":
+				d.write(" ")
+			case "_":
+				d.write("‑")
+			}
+
+		case rtfControl:
+			skipFallback = 0
+			switch tok.word {
+			case "ansicpg":
+				d.codepage = tok.param
+			case "htmlrtf":
+				g.htmlrtf = !tok.hasParam || tok.param != 0
+			case "uc":
+				g.uc = tok.param
+			case "u":
+				if output {
+					r := rune(tok.param)
+					if r < 0 {
+						r += 0x10000
+					}
+					d.writeUTF16(r)
+				}
+				skipFallback = g.uc
+			case "bin":
+				// Binary data; skip it.
+				if tok.param > 0 {
+					d.t.pos += tok.param
+				}
+			default:
+				if s, ok := rtfControlText[tok.word]; ok && output {
+					d.write(s)
+				}
+			}
+		}
+	}
+}
+
+func (d *rtfDecap) write(s string) {
+	d.flush()
+	d.out.WriteString(s)
+}
+
+// writeUTF16 writes the UTF-16 code unit r from a \u control word; a high
+// surrogate is kept until the low surrogate that follows it.
+func (d *rtfDecap) writeUTF16(r rune) {
+	if d.surrogate != 0 && len(d.pending) == 0 && r >= 0xdc00 && r < 0xe000 {
+		d.out.WriteRune(utf16.DecodeRune(d.surrogate, r))
+		d.surrogate = 0
+		return
+	}
+	d.flush()
+	if r >= 0xd800 && r < 0xdc00 {
+		d.surrogate = r
+		return
+	}
+	d.out.WriteRune(r)
+}
+
+// flush converts pending text from the codepage to UTF-8.
+func (d *rtfDecap) flush() {
+	if d.surrogate != 0 {
+		// High surrogate without a low surrogate.
+		d.out.WriteRune(utf8.RuneError)
+		d.surrogate = 0
+	}
+	if len(d.pending) == 0 {
+		return
+	}
+	// 8-bit text in RTF is never UTF-8 (that's written with \u), so
+	// unknown code pages such as \ansicpg0 are treated as Windows-1252.
+	cp := d.codepage
+	if charsetName(cp) == "" {
+		cp = 1252
+	}
+	d.out.WriteString(decodeCodepage(cp, d.pending))
+	d.pending = d.pending[:0]
+}
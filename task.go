@@ -0,0 +1,162 @@
+package tnef
+
+import (
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// Task is a task or task request; see Data.Task.
+type Task struct {
+	// Method is the iCalendar method that corresponds to the message class:
+	// "PUBLISH" for tasks, "REQUEST" for task requests and updates, and
+	// "REPLY" for accepted or declined task requests.
+	Method string
+
+	// UID is the global ID of the task in hex, or the MessageID if it's not
+	// set.
+	UID string
+
+	Subject     string
+	Description string
+	Priority    int // One of the Priority* constants.
+
+	Status          int     // One of the Task* constants.
+	PercentComplete float64 // From 0 to 1.
+	Complete        bool
+
+	// Start and Due are dates, stored as midnight in the owner's time zone;
+	// Completed is the date the task was completed. They're zero if not set.
+	Start     time.Time
+	Due       time.Time
+	Completed time.Time
+
+	// Owner is the name of the user the task is assigned to, and Assigner
+	// the name of the user who assigned it.
+	Owner     string
+	Assigner  string
+	Organizer Address
+	Attendees []Recipient
+
+	// Recurrence is the recurrence pattern (PidLidTaskRecurrence), if
+	// Recurring is set. It can be decoded with DecodeRecurrence.
+	Recurring  bool
+	Recurrence []byte
+
+	SentAt time.Time
+
+	// Problems found by WriteICalendar that didn't prevent writing the task.
+	Warnings []error
+
+	codepage int // Code page of 8-bit strings in the recurrence pattern.
+}
+
+// Task statuses, as stored in Task.Status.
+const (
+	TaskNotStarted = 0x0000
+	TaskInProgress = 0x0001
+	TaskComplete   = 0x0002
+	TaskWaiting    = 0x0003
+	TaskDeferred   = 0x0004
+)
+
+// Named properties in PSETIDTask and PSETIDCommon; see MS-OXOTASK.
+const (
+	lidTaskStatus        = 0x8101
+	lidPercentComplete   = 0x8102
+	lidTaskStartDate     = 0x8104
+	lidTaskDueDate       = 0x8105
+	lidTaskDateCompleted = 0x810F
+	lidTaskRecurrence    = 0x8116
+	lidTaskComplete      = 0x811C
+	lidTaskOwner         = 0x811F
+	lidTaskAssigner      = 0x8121
+	lidTaskFRecurring    = 0x8126
+	lidTaskGlobalID      = 0x8519
+)
+
+// Task gets the information of a task or task request, or nil if the message
+// isn't one (based on the MessageClass).
+//
+// Task requests store the task as an embedded message; it's used if it's
+// present.
+func (d *Data) Task() *Task {
+	method := taskMethod(d.MessageClass)
+	if method == "" {
+		return nil
+	}
+
+	// Use the embedded task, with the method and addresses of the request.
+	src := d
+	if method != "PUBLISH" {
+		for _, a := range d.Attachments {
+			if a.Embedded != nil && taskMethod(a.Embedded.MessageClass) == "PUBLISH" {
+				src = a.Embedded
+				break
+			}
+		}
+	}
+
+	named := func(set GUID, id int32) MAPIAttribute {
+		attr, _ := findNamed(src.Attributes, set, id)
+		return attr
+	}
+
+	text, _ := src.TextBody()
+	t := &Task{
+		Method:      method,
+		Subject:     src.Subject,
+		Description: text,
+		Priority:    src.Priority,
+		Organizer:   d.From,
+		Attendees:   d.Recipients,
+		SentAt:      d.SentAt,
+		codepage:    src.Codepage,
+	}
+	t.Status, _ = named(PSETIDTask, lidTaskStatus).Int()
+	t.PercentComplete, _ = named(PSETIDTask, lidPercentComplete).Float()
+	t.Complete, _ = named(PSETIDTask, lidTaskComplete).Bool()
+	t.Start = taskDate(named(PSETIDTask, lidTaskStartDate))
+	t.Due = taskDate(named(PSETIDTask, lidTaskDueDate))
+	t.Completed = taskDate(named(PSETIDTask, lidTaskDateCompleted))
+	t.Owner, _ = named(PSETIDTask, lidTaskOwner).StringValue()
+	t.Assigner, _ = named(PSETIDTask, lidTaskAssigner).StringValue()
+	t.Recurring, _ = named(PSETIDTask, lidTaskFRecurring).Bool()
+	if t.Recurring {
+		t.Recurrence, _ = named(PSETIDTask, lidTaskRecurrence).Binary()
+	}
+
+	if b, err := named(PSETIDCommon, lidTaskGlobalID).Binary(); err == nil && len(b) > 0 {
+		t.UID = strings.ToUpper(hex.EncodeToString(b))
+	} else {
+		t.UID = src.MessageID
+	}
+
+	return t
+}
+
+// taskDate gets the value of a task date property. Outlook uses 4501-01-01
+// for dates that aren't set, so that's returned as the zero time.
+func taskDate(attr MAPIAttribute) time.Time {
+	t, err := attr.Time()
+	if err != nil || t.Year() >= 4500 {
+		return time.Time{}
+	}
+	return t
+}
+
+// taskMethod gets the iCalendar method for the message class, or "" if it's
+// not a task.
+func taskMethod(class string) string {
+	class = strings.ToLower(class)
+	switch {
+	case class == "ipm.task" || strings.HasPrefix(class, "ipm.task."):
+		return "PUBLISH"
+	case strings.HasPrefix(class, "ipm.taskrequest.accept"),
+		strings.HasPrefix(class, "ipm.taskrequest.decline"):
+		return "REPLY"
+	case class == "ipm.taskrequest" || strings.HasPrefix(class, "ipm.taskrequest."):
+		return "REQUEST"
+	}
+	return ""
+}
@@ -0,0 +1,158 @@
+package tnef
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func filetime(t time.Time) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(t.Unix()+filetimeUnixOffset)*1e7)
+	return b
+}
+
+// testTask creates a daily recurring IPM.Task with the named properties read
+// by Task.
+func testTask() *Data {
+	named := func(id int32, typ int, data []byte) MAPIAttribute {
+		return MAPIAttribute{Type: typ, Name: int(id), Data: data, PropSet: PSETIDTask, NamedID: id}
+	}
+
+	// Daily, ending after 2020-03-06.
+	var recur []byte
+	for _, v := range [][]byte{
+		le16(0x3004), le16(0x3004), le16(RecurDaily), le16(PatternDay), le16(0),
+		le32(0), le32(24 * 60), le32(0),
+		le32(EndAfterDate), le32(5), le32(1),
+		le32(0), le32(0),
+		recurrenceMinutes(date(2020, 3, 2, 0, 0)), recurrenceMinutes(date(2020, 3, 6, 0, 0)),
+	} {
+		recur = append(recur, v...)
+	}
+
+	return &Data{
+		MessageClass: "IPM.Task",
+		Subject:      "Write report",
+		Body:         []byte("Quarterly, with charts\x00"),
+		Priority:     PriorityHigh,
+		SentAt:       date(2020, 3, 1, 10, 0),
+		Attributes: []MAPIAttribute{
+			named(lidTaskStatus, szmapiInt, le32(TaskInProgress)),
+			named(lidPercentComplete, szmapiDouble, le64f(0.25)),
+			// Midnight in UTC+1.
+			named(lidTaskStartDate, szmapiSystime, filetime(date(2020, 3, 1, 23, 0))),
+			named(lidTaskDueDate, szmapiSystime, filetime(date(2020, 3, 5, 23, 0))),
+			named(lidTaskDateCompleted, szmapiSystime, filetime(date(4501, 1, 1, 0, 0))),
+			named(lidTaskOwner, szmapiUnicodeString, encodeUnicode("Bob")),
+			named(lidTaskFRecurring, szmapiBoolean, le32(1)),
+			named(lidTaskRecurrence, szmapiBinary, recur),
+			{Type: szmapiBinary, Name: lidTaskGlobalID, Data: []byte{0xab, 0xcd},
+				PropSet: PSETIDCommon, NamedID: lidTaskGlobalID},
+		},
+	}
+}
+
+func TestTask(t *testing.T) {
+	task := testTask().Task()
+	if task == nil {
+		t.Fatal("Task is nil")
+	}
+
+	if task.Method != "PUBLISH" {
+		t.Errorf("wrong Method: %q", task.Method)
+	}
+	if task.UID != "ABCD" {
+		t.Errorf("wrong UID: %q", task.UID)
+	}
+	if task.Status != TaskInProgress {
+		t.Errorf("wrong Status: %d", task.Status)
+	}
+	if task.PercentComplete != 0.25 {
+		t.Errorf("wrong PercentComplete: %v", task.PercentComplete)
+	}
+	if want := date(2020, 3, 1, 23, 0); !task.Start.Equal(want) {
+		t.Errorf("wrong Start: %v", task.Start)
+	}
+	if !task.Completed.IsZero() {
+		t.Errorf("Completed is not zero: %v", task.Completed)
+	}
+	if task.Owner != "Bob" {
+		t.Errorf("wrong Owner: %q", task.Owner)
+	}
+	if !task.Recurring || len(task.Recurrence) == 0 {
+		t.Error("Recurrence not set")
+	}
+
+	t.Run("request", func(t *testing.T) {
+		d := &Data{
+			MessageClass: "IPM.TaskRequest",
+			Subject:      "Task Request: Write report",
+			Attachments:  []*Attachment{{Embedded: testTask()}},
+		}
+		d.Attachments[0].Embedded.Codepage = 1251
+		task := d.Task()
+		if task.Method != "REQUEST" {
+			t.Errorf("wrong Method: %q", task.Method)
+		}
+		if task.Subject != "Write report" || task.Status != TaskInProgress {
+			t.Errorf("embedded task not used: %#v", task)
+		}
+		// The recurrence pattern is in the code page of the embedded task.
+		if task.codepage != 1251 {
+			t.Errorf("wrong codepage: %d", task.codepage)
+		}
+	})
+
+	t.Run("not a task", func(t *testing.T) {
+		if task := (&Data{MessageClass: "IPM.Note"}).Task(); task != nil {
+			t.Errorf("Task is not nil: %#v", task)
+		}
+	})
+}
+
+func TestWriteICalendarTask(t *testing.T) {
+	buf := new(bytes.Buffer)
+	if err := testTask().Task().WriteICalendar(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := strings.Replace(`BEGIN:VCALENDAR
+PRODID:-//Teamwork//tnef//EN
+VERSION:2.0
+METHOD:PUBLISH
+BEGIN:VTODO
+UID:ABCD
+DTSTAMP:20200301T100000Z
+DTSTART;VALUE=DATE:20200302
+DUE;VALUE=DATE:20200306
+RRULE:FREQ=DAILY;UNTIL=20200306
+SUMMARY:Write report
+DESCRIPTION:Quarterly\, with charts
+STATUS:IN-PROCESS
+PERCENT-COMPLETE:25
+PRIORITY:1
+END:VTODO
+END:VCALENDAR
+`, "\n", "\r\n", -1)
+	if got := buf.String(); got != want {
+		t.Errorf("\ngot:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Non-Gregorian calendar; written without the RRULE.
+	task := testTask().Task()
+	task.Recurrence[8] = 6
+	buf.Reset()
+	if err := task.WriteICalendar(buf); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "RRULE") {
+		t.Errorf("RRULE was written:\n%s", buf.String())
+	}
+	if len(task.Warnings) != 1 || !errors.Is(task.Warnings[0], ErrUnsupportedRecurrence) {
+		t.Errorf("wrong warnings: %v", task.Warnings)
+	}
+}
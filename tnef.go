@@ -2,17 +2,30 @@
 package tnef // import "github.com/teamwork/tnef"
 
 import (
+	"bytes"
 	"errors"
-	"io/ioutil"
-	"strings"
+	"io"
+	"os"
+	"time"
 )
 
 const (
 	tnefSignature = 0x223e9f78
-	//lvlMessage    = 0x01
+	lvlMessage    = 0x01
 	lvlAttachment = 0x02
 )
 
+// Attribute types; these are stored in the Type field of TNEF objects.
+const (
+	atpTriples = 0x0000
+	atpString  = 0x0001
+	atpDate    = 0x0003
+	atpShort   = 0x0004
+	atpByte    = 0x0006
+	atpWord    = 0x0007
+	atpDword   = 0x0008
+)
+
 // These can be used to figure out the type of attribute
 // an object is
 const (
@@ -51,11 +64,11 @@ const (
 )
 
 type tnefObject struct {
+	Offset int64
 	Level  int
 	Name   int
 	Type   int
 	Data   []byte
-	Length int
 }
 
 // Attachment contains standard attachments that are embedded
@@ -63,67 +76,235 @@ type tnefObject struct {
 type Attachment struct {
 	Title string
 	Data  []byte
+
+	// MAPI properties of the attachment, and some commonly used properties
+	// extracted from them.
+	Attributes        []MAPIAttribute
+	LongFilename      string // MAPIAttachLongFilename
+	MimeType          string // MAPIAttachMimeTag
+	ContentID         string // MAPIAttachContentID
+	Method            int    // MAPIAttachMethod; one of the Attach* constants.
+	RenderingPosition int    // MAPIRenderingPosition; -1 if it's not rendered in the body or not set.
+
+	// Embedded is the decoded message for attachments that are an embedded
+	// message (Method is AttachEmbeddedMsg), such as forwarded Outlook items.
+	Embedded *Data
+
+	transportFilename string // ATTATTACHTRANSPORTFILENAME
 }
 
+// Attachment methods, as stored in Attachment.Method.
+const (
+	AttachNone         = 0x0000
+	AttachByValue      = 0x0001
+	AttachByReference  = 0x0002
+	AttachByRefResolve = 0x0003
+	AttachByRefOnly    = 0x0004
+	AttachEmbeddedMsg  = 0x0005
+	AttachOLE          = 0x0006
+)
+
 // ErrNoMarker signals that the file did not start with the fixed TNEF marker,
 // meaning it's not in the TNEF file format we recognize (e.g. it just has the
 // .tnef extension, or a wrong MIME type).
 var ErrNoMarker = errors.New("file did not begin with a TNEF marker")
 
+// ErrChecksum signals that the checksum of a TNEF object didn't match its data.
+//
+// It's wrapped in a *FormatError, which names the attribute.
+var ErrChecksum = errors.New("checksum mismatch")
+
+// DecodeOptions controls how TNEF data is decoded.
+type DecodeOptions struct {
+	// Strict makes decoding fail with ErrChecksum if the checksum of an
+	// object is wrong, with ErrCompressedRTF if the RTF body can't be
+	// decompressed, with ErrMaxDepth if embedded messages are nested too
+	// deeply, or with a *FormatError if a MAPI property has an unknown type
+	// or an object is invalid. By default these problems are recorded in
+	// Data.Warnings and decoding continues: the rest of a MAPI block after a
+	// property with an unknown type is skipped, and the data decoded before
+	// an invalid object is returned.
+	Strict bool
+
+	// MaxDepth is the maximum nesting depth of embedded messages that are
+	// decoded in to Attachment.Embedded; 0 means DefaultMaxDepth, and -1
+	// means embedded messages aren't decoded.
+	MaxDepth int
+}
+
+// DefaultMaxDepth is the default value of DecodeOptions.MaxDepth.
+const DefaultMaxDepth = 8
+
+// ErrMaxDepth signals that embedded messages are nested deeper than
+// DecodeOptions.MaxDepth.
+var ErrMaxDepth = errors.New("embedded messages nested too deeply")
+
 // Data contains the various data from the extracted TNEF file.
 type Data struct {
-	Body        []byte
-	BodyHTML    []byte
+	// Body and BodyHTML are the plain text and HTML bodies as stored in the
+	// file, in the code page of the message or UTF-16. If the message only
+	// has an RTF body with encapsulated text or HTML they're decapsulated
+	// from BodyRTF instead, and are UTF-8. Use TextBody and HTMLBody to
+	// always get UTF-8.
+	Body     []byte
+	BodyHTML []byte
+	BodyRTF  []byte // Decompressed from MAPIRtfCompressed.
+
 	Attachments []*Attachment
 	Attributes  []MAPIAttribute
+	Recipients  []Recipient
+
+	// Commonly used message properties. These are taken from the MAPI
+	// properties, or from the equivalent legacy TNEF attributes if they
+	// aren't present.
+	//
+	// SentAt and ReceivedAt are from PR_CLIENT_SUBMIT_TIME and
+	// PR_MESSAGE_DELIVERY_TIME, which are in UTC. The fallbacks ATTDATESENT
+	// and ATTDATERECD are the sender's local time in an unknown time zone;
+	// that time is used as-is with the time.UTC location.
+	Subject      string
+	From         Address
+	SentAt       time.Time
+	ReceivedAt   time.Time
+	MessageID    string // ID in the sender's message store in hex; not the Message-ID header.
+	Priority     int    // One of the Priority* constants.
+	MessageClass string // E.g. "IPM.Note" or "IPM.Appointment".
+	TNEFVersion  int
+
+	// Codepage is the Windows code page of the 8-bit strings in the
+	// message, from ATTOEMCODEPAGE or the PR_MESSAGE_CODEPAGE or
+	// PR_INTERNET_CPID properties; 0 if it's not known. Strings in the typed
+	// fields and from MAPIAttribute.StringValue are already converted to UTF-8.
+	Codepage int
+
+	// Problems that didn't prevent decoding the data, such as checksum
+	// mismatches in lenient mode.
+	Warnings []error
+
+	legacy []tnefObject // Other legacy message attributes.
+
+	// Body or BodyHTML was decapsulated from BodyRTF, and is UTF-8.
+	textFromRTF, htmlFromRTF bool
+}
+
+// newAttachment creates an attachment for decoding, which isn't rendered in the
+// body unless PR_RENDERING_POSITION says so.
+func newAttachment() *Attachment {
+	return &Attachment{RenderingPosition: -1}
 }
 
-func (a *Attachment) addAttr(obj tnefObject) {
+// addAttr adds the attachment attribute in obj; 8-bit strings are in the code
+// page cp. Problems that aren't fatal are recorded as warnings of d.
+func (a *Attachment) addAttr(obj tnefObject, d *Decoder, cp int) error {
 	switch obj.Name {
 	case ATTATTACHTITLE:
-		a.Title = strings.Replace(string(obj.Data), "\x00", "", -1)
+		a.Title = legacyString(cp, obj.Data)
 	case ATTATTACHDATA:
 		a.Data = obj.Data
+	case ATTATTACHTRANSPORTFILENAME:
+		a.transportFilename = legacyString(cp, obj.Data)
+	case ATTATTACHMENT:
+		var err error
+		a.Attributes, err = decodeMapi(obj.Data, obj.Offset+objectHeaderSize, d)
+		if err != nil {
+			return err
+		}
+		setCodepage(a.Attributes, cp)
+
+		for _, attr := range a.Attributes {
+			switch attr.Name {
+			case MAPIAttachLongFilename:
+				a.LongFilename, _ = attr.StringValue()
+			case MAPIAttachMimeTag:
+				a.MimeType, _ = attr.StringValue()
+			case MAPIAttachContentID:
+				a.ContentID, _ = attr.StringValue()
+			case MAPIAttachMethod:
+				a.Method, _ = attr.Int()
+			case MAPIRenderingPosition:
+				a.RenderingPosition, _ = attr.Int()
+			}
+		}
 	}
+	return nil
 }
 
-// DecodeFile is a utility function that reads the file into memory
-// before calling the normal Decode function on the data.
+// DecodeFile is a utility function that reads the file at path before calling
+// the normal Decode function on the data.
 func DecodeFile(path string) (*Data, error) {
-	data, err := ioutil.ReadFile(path)
+	fp, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
+	defer fp.Close() // nolint: errcheck
 
-	return Decode(data)
+	return decode(NewDecoder(fp))
 }
 
 // Decode will accept a stream of bytes in the TNEF format and extract the
 // attachments and body into a Data object.
+//
+// Use NewDecoder to process large files without reading the entire file in to
+// memory.
 func Decode(data []byte) (*Data, error) {
-	if byteToInt(data[0:4]) != tnefSignature {
-		return nil, ErrNoMarker
-	}
+	return DecodeWithOptions(data, DecodeOptions{})
+}
 
-	//key := binary.LittleEndian.Uint32(data[4:6])
-	offset := 6
-	var attachment *Attachment
+// DecodeWithOptions is like Decode, but with options to control the decoding.
+func DecodeWithOptions(data []byte, opts DecodeOptions) (*Data, error) {
+	d := NewDecoder(bytes.NewReader(data))
+	d.Options = opts
+	return decode(d)
+}
+
+func decode(d *Decoder) (*Data, error) {
+	var (
+		attachment *Attachment
+		obj        tnefObject
+	)
 	tnef := &Data{
 		Attachments: []*Attachment{},
 	}
 
-	for offset < len(data) {
-		obj := decodeTNEFObject(data[offset:])
-		offset += obj.Length
+	for {
+		o, err := d.Next()
+		if err == nil {
+			obj = tnefObject{Offset: o.Offset, Level: o.Level, Name: o.Name, Type: o.Type}
+			obj.Data, err = o.Bytes()
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// Keep what was decoded so far if the rest of the file is
+			// invalid, e.g. because there's garbage at the end.
+			if _, ok := err.(*FormatError); ok && !d.Options.Strict {
+				d.warnings = append(d.warnings, err)
+				break
+			}
+			return nil, err
+		}
 
 		if obj.Name == ATTATTACHRENDDATA {
-			attachment = new(Attachment)
+			attachment = newAttachment()
 			tnef.Attachments = append(tnef.Attachments, attachment)
 		} else if obj.Level == lvlAttachment {
-			attachment.addAttr(obj)
+			// Should always start with ATTATTACHRENDDATA, but don't crash
+			// if it doesn't.
+			if attachment == nil {
+				attachment = newAttachment()
+				tnef.Attachments = append(tnef.Attachments, attachment)
+			}
+			if err := attachment.addAttr(obj, d, tnef.Codepage); err != nil {
+				return nil, err
+			}
+			if obj.Name == ATTATTACHMENT {
+				if err := d.attachDataObj(attachment); err != nil {
+					return nil, err
+				}
+			}
 		} else if obj.Name == ATTMAPIPROPS {
-			var err error
-			tnef.Attributes, err = decodeMapi(obj.Data)
+			tnef.Attributes, err = decodeMapi(obj.Data, obj.Offset+objectHeaderSize, d)
 			if err != nil {
 				return nil, err
 			}
@@ -135,30 +316,52 @@ func Decode(data []byte) (*Data, error) {
 					tnef.Body = attr.Data
 				case MAPIBodyHTML:
 					tnef.BodyHTML = attr.Data
+				case MAPIRtfCompressed:
+					tnef.BodyRTF, err = DecompressRTF(attr.Data)
+					if err != nil {
+						err = &FormatError{Offset: o.Offset, Attr: attr.Name, Msg: err.Error(), Err: ErrCompressedRTF}
+						if d.Options.Strict {
+							return nil, err
+						}
+						d.warnings = append(d.warnings, err)
+					}
 				}
 			}
+		} else if obj.Name == ATTRECIPTABLE {
+			tnef.Recipients, err = decodeRecipients(obj, d, tnef.Codepage)
+			if err != nil {
+				return nil, err
+			}
+		} else if err := tnef.addAttr(obj); err != nil {
+			return nil, err
 		}
 	}
 
-	return tnef, nil
-}
+	// ATTOEMCODEPAGE isn't always before the strings and MAPI properties.
+	if tnef.Codepage == 0 {
+		tnef.Codepage = mapiCodepage(tnef.Attributes)
+	}
+	setCodepage(tnef.Attributes, tnef.Codepage)
+	if err := tnef.legacyFields(); err != nil {
+		return nil, err
+	}
+	tnef.mapiFields()
+
+	// Outlook often only stores the RTF body, with the original HTML or text
+	// encapsulated in it.
+	if len(tnef.BodyRTF) > 0 && len(tnef.BodyHTML) == 0 {
+		body, isHTML, err := DecapsulateRTF(tnef.BodyRTF)
+		switch {
+		case err == ErrNotEncapsulated:
+		case err != nil:
+			d.warnings = append(d.warnings, err)
+		case isHTML:
+			tnef.BodyHTML, tnef.htmlFromRTF = body, true
+		case len(tnef.Body) == 0:
+			tnef.Body, tnef.textFromRTF = body, true
+		}
+	}
 
-func decodeTNEFObject(data []byte) (object tnefObject) {
-	offset := 0
-
-	object.Level = byteToInt(data[offset : offset+1])
-	offset++
-	object.Name = byteToInt(data[offset : offset+2])
-	offset += 2
-	object.Type = byteToInt(data[offset : offset+2])
-	offset += 2
-	attLength := byteToInt(data[offset : offset+4])
-	offset += 4
-	object.Data = data[offset : offset+attLength]
-	offset += attLength
-	//checksum := byteToInt(data[offset : offset+2])
-	offset += 2
-
-	object.Length = offset
-	return
+	tnef.Warnings = d.Warnings()
+	return tnef, nil
 }
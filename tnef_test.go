@@ -1,6 +1,10 @@
 package tnef
 
 import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
 	"testing"
 
 	"github.com/teamwork/test"
@@ -17,21 +21,7 @@ func TestAttachments(t *testing.T) {
 			"ZAPPA_~2.JPG",
 			"bookmark.htm",
 		}, ""},
-		// will panic!
-		//{"panic", []string{
-		//	"ZAPPA_~2.JPG",
-		//	"bookmark.htm",
-		//}},
-		//{"MAPI_ATTACH_DATA_OBJ", []string{
-		//	"VIA_Nytt_1402.doc",
-		//	"VIA_Nytt_1402.pdf",
-		//	"VIA_Nytt_14021.htm",
-		//	"MAPI_ATTACH_DATA_OBJ-body.rtf",
-		//}},
-		//{"MAPI_OBJECT", []string{
-		//	"Untitled_Attachment",
-		//	"MAPI_OBJECT-body.rtf",
-		//}},
+		// MAPI_ATTACH_DATA_OBJ and MAPI_OBJECT are in TestAttachDataObj.
 		//{"body", []string{
 		//	"body-body.html",
 		//}},
@@ -49,19 +39,15 @@ func TestAttachments(t *testing.T) {
 		//	"missing-filenames-body.rtf",
 		//}},
 		{"multi-name-property", []string{}, ""},
-		//{"multi-value-attribute", []string{
-		//	"208225__5_seconds__Voice_Mail.mp3",
-		//	"multi-value-attribute-body.rtf",
-		//}},
+		{"multi-value-attribute", []string{
+			"208225~1.mp3",
+		}, ""},
 		{"one-file", []string{
 			"AUTHORS",
 		}, ""},
-		//{"rtf", []string{
-		//	"rtf-body.rtf",
-		//}},
-		//{"triples", []string{
-		//	"triples-body.rtf",
-		//}},
+		// The RTF body is in BodyRTF; see TestBodyRTF.
+		{"rtf", []string{}, ""},
+		{"triples", []string{}, ""},
 		{"two-files", []string{
 			"AUTHORS",
 			"README",
@@ -75,6 +61,12 @@ func TestAttachments(t *testing.T) {
 		{"unicode-mapi-attr", []string{
 			"example.dat",
 		}, ""},
+		// Garbage after the attachments; see TestDecodeInvalidObject.
+		{"panic", []string{
+			"image001.jpg",
+			"image002.jpg",
+			"image003.png",
+		}, ""},
 
 		// Invalid files.
 		{"badchecksum", nil, ErrNoMarker.Error()},
@@ -110,3 +102,232 @@ func TestAttachments(t *testing.T) {
 		})
 	}
 }
+
+// Make sure that truncated or corrupted files don't panic.
+func TestDecodeCorrupt(t *testing.T) {
+	for _, in := range []string{"attachments", "body", "triples", "unicode-mapi-attr-name"} {
+		data := test.Read(t, "./testdata", in+".tnef")
+		step := len(data)/500 + 1
+		for i := 0; i < len(data); i += step {
+			_, err := Decode(data[:i])
+			if err == nil {
+				continue
+			}
+			if _, ok := err.(*FormatError); !ok && err != ErrNoMarker {
+				t.Errorf("%s truncated at %d: wrong error type: %#v", in, i, err)
+			}
+		}
+
+		for i := 6; i < len(data); i += step {
+			corrupt := make([]byte, len(data))
+			copy(corrupt, data)
+			corrupt[i] ^= 0xff
+			_, err := Decode(corrupt)
+			if err == nil {
+				continue
+			}
+			if _, ok := err.(*FormatError); !ok {
+				t.Errorf("%s corrupted at %d: wrong error type: %#v", in, i, err)
+			}
+		}
+	}
+}
+
+func TestDecodeInvalidObject(t *testing.T) {
+	data := test.Read(t, "./testdata", "panic.tnef")
+
+	out, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Attachments) != 3 {
+		t.Errorf("wrong number of attachments: %d", len(out.Attachments))
+	}
+	if len(out.Warnings) != 1 || !test.ErrorContains(out.Warnings[0], "invalid level 75") {
+		t.Errorf("wrong warnings: %v", out.Warnings)
+	}
+
+	_, err = DecodeWithOptions(data, DecodeOptions{Strict: true})
+	if _, ok := err.(*FormatError); !ok || !test.ErrorContains(err, "invalid level 75") {
+		t.Errorf("wrong error: %#v", err)
+	}
+}
+
+func TestUnknownPropertyType(t *testing.T) {
+	// Three integers, with the property type typ in between.
+	props := func(typ int) []byte {
+		var b []byte
+		b = append(b, le32(4)...)
+		b = append(b, append(append(le16(szmapiInt), le16(0x6801)...), le32(1)...)...)
+		b = append(b, append(le16(uint16(typ)), le16(0x6802)...)...)
+		b = append(b, append(append(le16(szmapiInt), le16(0x6803)...), le32(2)...)...)
+		b = append(b, append(append(le16(szmapiInt), le16(0x6804)...), le32(3)...)...)
+
+		buf := new(bytes.Buffer)
+		e := &encoder{w: buf}
+		e.uint32(tnefSignature)
+		e.uint16(legacyKey)
+		e.object(lvlMessage, ATTMAPIPROPS, atpByte, b)
+		return buf.Bytes()
+	}
+	names := func(attrs []MAPIAttribute) []int {
+		var n []int
+		for _, attr := range attrs {
+			n = append(n, attr.Name)
+		}
+		return n
+	}
+
+	// PT_NULL and PT_UNSPECIFIED have no value, and are skipped.
+	for _, typ := range []int{szmapiNull, szmapiUnspecified} {
+		d, err := DecodeWithOptions(props(typ), DecodeOptions{Strict: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := names(d.Attributes); !reflect.DeepEqual(got, []int{0x6801, 0x6803, 0x6804}) {
+			t.Errorf("0x%04x: wrong attributes: %#v", typ, got)
+		}
+		if len(d.Warnings) != 0 {
+			t.Errorf("0x%04x: wrong warnings: %v", typ, d.Warnings)
+		}
+	}
+
+	// The size of other types isn't known, so decoding the block stops.
+	data := props(0x0099)
+	d, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := names(d.Attributes); !reflect.DeepEqual(got, []int{0x6801}) {
+		t.Errorf("wrong attributes: %#v", got)
+	}
+	if len(d.Warnings) != 1 {
+		t.Errorf("wrong warnings: %v", d.Warnings)
+	}
+
+	_, err = DecodeWithOptions(data, DecodeOptions{Strict: true})
+	if _, ok := err.(*FormatError); !ok {
+		t.Errorf("wrong error: %#v", err)
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	data := test.Read(t, "./testdata", "attachments.tnef")
+	data[1438+objectHeaderSize+100] ^= 0xff // Attachment data of ZAPPA_~2.JPG
+
+	t.Run("strict", func(t *testing.T) {
+		_, err := DecodeWithOptions(data, DecodeOptions{Strict: true})
+		if !errors.Is(err, ErrChecksum) {
+			t.Fatalf("wrong error: %v", err)
+		}
+		if fErr := err.(*FormatError); fErr.Attr != ATTATTACHDATA {
+			t.Errorf("wrong attribute: 0x%04x", fErr.Attr)
+		}
+	})
+
+	t.Run("lenient", func(t *testing.T) {
+		out, err := Decode(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(out.Warnings) != 1 || !errors.Is(out.Warnings[0], ErrChecksum) {
+			t.Fatalf("wrong warnings: %v", out.Warnings)
+		}
+		if len(out.Attachments) != 2 {
+			t.Errorf("wrong number of attachments: %d", len(out.Attachments))
+		}
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		out, err := DecodeWithOptions(test.Read(t, "./testdata", "attachments.tnef"),
+			DecodeOptions{Strict: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(out.Warnings) != 0 {
+			t.Errorf("unexpected warnings: %v", out.Warnings)
+		}
+	})
+}
+
+func TestAttachmentAttributes(t *testing.T) {
+	out, err := Decode(test.Read(t, "./testdata", "unicode-mapi-attr-name.tnef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := out.Attachments[1]
+	if a.LongFilename != "image001.png" {
+		t.Errorf("wrong LongFilename: %q", a.LongFilename)
+	}
+	if a.MimeType != "image/png" {
+		t.Errorf("wrong MimeType: %q", a.MimeType)
+	}
+	if a.ContentID != "image001.png@01CF8C82.F4A2A290" {
+		t.Errorf("wrong ContentID: %q", a.ContentID)
+	}
+	if a.Method != AttachByValue {
+		t.Errorf("wrong Method: %d", a.Method)
+	}
+	if len(a.Attributes) == 0 {
+		t.Error("no Attributes")
+	}
+}
+
+func TestRenderingPosition(t *testing.T) {
+	data := test.Read(t, "./testdata", "attachments.tnef")
+	out, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := out.Attachments[0].RenderingPosition; p != 93 {
+		t.Errorf("wrong RenderingPosition: %d", p)
+	}
+
+	// Copy the file without PR_RENDERING_POSITION.
+	buf := new(bytes.Buffer)
+	e := &encoder{w: buf}
+	e.uint32(tnefSignature)
+	e.uint16(legacyKey)
+	dec := NewDecoder(bytes.NewReader(data))
+	for {
+		o, err := dec.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := o.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o.Name == ATTATTACHMENT {
+			attrs, err := decodeMapi(b, 0, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var keep []MAPIAttribute
+			for _, attr := range attrs {
+				if attr.Name != MAPIRenderingPosition {
+					keep = append(keep, attr)
+				}
+			}
+			b = encodeMapi(keep)
+		}
+		e.object(o.Level, o.Name, o.Type, b)
+	}
+
+	out, err = Decode(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Attachments) != 2 {
+		t.Fatalf("wrong number of attachments: %d", len(out.Attachments))
+	}
+	for _, a := range out.Attachments {
+		if a.RenderingPosition != -1 {
+			t.Errorf("%s: wrong RenderingPosition: %d", a.Title, a.RenderingPosition)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package tnef
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+)
+
+// UnwrapMIME reads an RFC 5322 email message from r, and writes it to w with
+// every TNEF part (application/ms-tnef, or named winmail.dat) replaced by the
+// body and attachments of the decoded TNEF data, as written by WriteMIME.
+//
+// The headers of the message are kept, and so are the other parts; the
+// preamble and epilogue of multipart entities are dropped. Parts that can't be
+// decoded as TNEF are kept as-is, and the message is written unchanged if it
+// doesn't contain any TNEF parts.
+//
+// It returns the number of TNEF parts that were replaced.
+func UnwrapMIME(w io.Writer, r io.Reader) (int, error) {
+	in, err := ioutil.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	msg, err := mail.ReadMessage(bytes.NewReader(in))
+	if err != nil {
+		return 0, err
+	}
+	body, err := ioutil.ReadAll(msg.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	e, n := unwrapEntity(textproto.MIMEHeader(msg.Header), body)
+	if n == 0 {
+		_, err := w.Write(in)
+		return 0, err
+	}
+
+	// Write the header fields of the message in the original order, followed
+	// by the Content-* fields of the new entity.
+	var b bytes.Buffer
+	for _, field := range headerFields(in[:len(in)-len(body)]) {
+		if !strings.HasPrefix(textproto.CanonicalMIMEHeaderKey(field.name), "Content-") {
+			b.Write(field.raw)
+		}
+	}
+	content := make(textproto.MIMEHeader)
+	for k, v := range e.header {
+		if strings.HasPrefix(k, "Content-") {
+			content[k] = v
+		}
+	}
+	if _, err := w.Write(b.Bytes()); err != nil {
+		return 0, err
+	}
+	if err := writeMIMEHeader(w, content); err != nil {
+		return 0, err
+	}
+	return n, e.body(w)
+}
+
+type headerField struct {
+	name string
+	raw  []byte // Including continuation lines and line breaks.
+}
+
+// headerFields splits the header of a message in to the fields.
+func headerFields(header []byte) []headerField {
+	var fields []headerField
+	for len(header) > 0 {
+		i := bytes.IndexByte(header, '\n') + 1
+		if i == 0 {
+			i = len(header)
+		}
+		line := header[:i]
+		header = header[i:]
+
+		switch {
+		case len(bytes.TrimSpace(line)) == 0:
+			// Blank line at the end of the header.
+		case (line[0] == ' ' || line[0] == '\t') && len(fields) > 0:
+			f := &fields[len(fields)-1]
+			f.raw = append(f.raw, line...)
+		default:
+			name := line
+			if j := bytes.IndexByte(line, ':'); j > -1 {
+				name = line[:j]
+			}
+			fields = append(fields, headerField{
+				name: string(bytes.TrimSpace(name)),
+				raw:  append([]byte(nil), line...),
+			})
+		}
+	}
+	return fields
+}
+
+// unwrapEntity gets the entity with the header and body, with TNEF parts in it
+// replaced.
+func unwrapEntity(h textproto.MIMEHeader, body []byte) (*mimeEntity, int) {
+	raw := &mimeEntity{header: h, body: func(w io.Writer) error {
+		_, err := w.Write(body)
+		return err
+	}}
+
+	mediaType, params, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		// Not a MIME message, or an invalid Content-Type; text/plain is
+		// assumed for both.
+		return raw, 0
+	}
+
+	if isTNEFPart(h, mediaType, params) {
+		data, err := decodeTransferEncoding(h.Get("Content-Transfer-Encoding"), body)
+		if err != nil {
+			return raw, 0
+		}
+		d, err := Decode(data)
+		if err != nil {
+			return raw, 0
+		}
+
+		// Keep the headers that aren't about the content, e.g. of the
+		// message.
+		e := d.mimeEntity()
+		for k, v := range h {
+			if !strings.HasPrefix(k, "Content-") {
+				e.header[k] = v
+			}
+		}
+		return e, 1
+	}
+
+	boundary := params["boundary"]
+	if !strings.HasPrefix(mediaType, "multipart/") || boundary == "" {
+		return raw, 0
+	}
+
+	var (
+		parts []*mimeEntity
+		total int
+	)
+	r := multipart.NewReader(bytes.NewReader(body), boundary)
+	for {
+		// NextRawPart doesn't decode quoted-printable, so the parts can be
+		// written as-is.
+		p, err := r.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// Malformed multipart; keep the entity as-is.
+			return raw, 0
+		}
+		b, err := ioutil.ReadAll(p)
+		if err != nil {
+			return raw, 0
+		}
+
+		e, n := unwrapEntity(p.Header, b)
+		parts = append(parts, e)
+		total += n
+	}
+	if total == 0 {
+		return raw, 0
+	}
+
+	// Keep the boundary if possible, so the Content-Type doesn't change.
+	if multipart.NewWriter(ioutil.Discard).SetBoundary(boundary) != nil {
+		boundary = multipart.NewWriter(ioutil.Discard).Boundary()
+		params["boundary"] = boundary
+		h = copyHeader(h)
+		h.Set("Content-Type", mime.FormatMediaType(mediaType, params))
+	}
+	return &mimeEntity{header: h, body: writeParts(boundary, parts)}, total
+}
+
+// isTNEFPart reports if the part with the header and media type contains
+// TNEF data.
+func isTNEFPart(h textproto.MIMEHeader, mediaType string, params map[string]string) bool {
+	switch mediaType {
+	case "application/ms-tnef", "application/vnd.ms-tnef":
+		return true
+	}
+
+	name := params["name"]
+	if _, dparams, err := mime.ParseMediaType(h.Get("Content-Disposition")); err == nil && dparams["filename"] != "" {
+		name = dparams["filename"]
+	}
+	return strings.EqualFold(name, "winmail.dat")
+}
+
+// decodeTransferEncoding decodes the body of a part with the
+// Content-Transfer-Encoding.
+func decodeTransferEncoding(encoding string, body []byte) ([]byte, error) {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return ioutil.ReadAll(base64.NewDecoder(base64.StdEncoding, bytes.NewReader(body)))
+	case "quoted-printable":
+		return ioutil.ReadAll(quotedprintable.NewReader(bytes.NewReader(body)))
+	}
+	return body, nil
+}
+
+func copyHeader(h textproto.MIMEHeader) textproto.MIMEHeader {
+	c := make(textproto.MIMEHeader, len(h))
+	for k, v := range h {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,91 @@
+package tnef
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/teamwork/test"
+)
+
+func TestUnwrapMIME(t *testing.T) {
+	winmail := base64.StdEncoding.EncodeToString(test.Read(t, "./testdata", "two-files.tnef"))
+	in := strings.Replace(`From: alice@example.com
+Subject: Files
+MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="XXX"
+
+preamble
+--XXX
+Content-Type: text/plain; charset=utf-8
+Content-Transfer-Encoding: quoted-printable
+
+Two files=3D
+--XXX
+Content-Type: application/octet-stream; name="WINMAIL.DAT"
+Content-Transfer-Encoding: base64
+
+`+winmail+`
+--XXX--
+`, "\n", "\r\n", -1)
+
+	out := new(bytes.Buffer)
+	n, err := UnwrapMIME(out, strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("replaced %d parts", n)
+	}
+
+	// The header fields are kept in order.
+	if want := "From: alice@example.com\r\nSubject: Files\r\nMIME-Version: 1.0\r\n" +
+		"Content-Type: multipart/mixed; boundary=\"XXX\"\r\n\r\n"; !strings.HasPrefix(out.String(), want) {
+		t.Errorf("wrong header:\n%s", out)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(out.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.Header.Get("Subject"); got != "Files" {
+		t.Errorf("wrong Subject: %q", got)
+	}
+
+	body, err := ioutil.ReadAll(msg.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The other part is kept as-is, without decoding the quoted-printable.
+	if !bytes.Contains(body, []byte("\r\n\r\nTwo files=3D\r\n--XXX")) {
+		t.Errorf("text part changed:\n%s", body)
+	}
+	parts := mimeParts(t, msg.Header.Get("Content-Type"), body)
+	for _, key := range []string{
+		"multipart/mixed text/plain",
+		"multipart/mixed multipart/mixed application/octet-stream AUTHORS",
+		"multipart/mixed multipart/mixed application/octet-stream README",
+	} {
+		if _, ok := parts[key]; !ok {
+			t.Errorf("part %q not found in %v", key, parts)
+		}
+	}
+
+	t.Run("no TNEF", func(t *testing.T) {
+		in := "Subject: Hello\r\nContent-Type: text/plain\r\n\r\nHello\r\n"
+		out := new(bytes.Buffer)
+		n, err := UnwrapMIME(out, strings.NewReader(in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != 0 {
+			t.Errorf("replaced %d parts", n)
+		}
+		if out.String() != in {
+			t.Errorf("message changed:\n%s", out)
+		}
+	})
+}
@@ -1,5 +1,24 @@
 package tnef
 
+import "fmt"
+
+// FormatError is returned when the TNEF data is malformed, for example when a
+// length points past the end of the data.
+type FormatError struct {
+	Offset int64  // Byte offset in the TNEF data where the problem was found.
+	Attr   int    // ID of the TNEF attribute or MAPI property being decoded.
+	Msg    string // Description of the problem.
+	Err    error  // Underlying error, if any (e.g. ErrChecksum).
+}
+
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("tnef: malformed data at offset %d (attribute 0x%04x): %s",
+		e.Offset, e.Attr, e.Msg)
+}
+
+// Unwrap returns the underlying error.
+func (e *FormatError) Unwrap() error { return e.Err }
+
 func byteToInt(data []byte) int {
 	var num int
 	var n uint
@@ -9,3 +28,69 @@ func byteToInt(data []byte) int {
 	}
 	return num
 }
+
+// buffer reads little-endian values from data, returning a FormatError if
+// there isn't enough data left.
+type buffer struct {
+	data []byte
+	off  int
+	base int64    // Offset of data in the TNEF stream, for errors.
+	attr int      // Attribute currently being read, for errors.
+	dec  *Decoder // For the options and warnings; may be nil.
+}
+
+func (b *buffer) len() int { return len(b.data) - b.off }
+
+func (b *buffer) errorf(format string, a ...interface{}) error {
+	return &FormatError{
+		Offset: b.base + int64(b.off),
+		Attr:   b.attr,
+		Msg:    fmt.Sprintf(format, a...),
+	}
+}
+
+// warn records err as a warning of the decoder and returns nil, or returns err
+// in strict mode or if there is no decoder.
+func (b *buffer) warn(err error) error {
+	if b.dec == nil || b.dec.Options.Strict {
+		return err
+	}
+	b.dec.warnings = append(b.dec.warnings, err)
+	return nil
+}
+
+// bytes reads the next n bytes.
+func (b *buffer) bytes(n int) ([]byte, error) {
+	if n < 0 || n > b.len() {
+		return nil, b.errorf("need %d bytes but only %d left", n, b.len())
+	}
+	d := b.data[b.off : b.off+n]
+	b.off += n
+	return d, nil
+}
+
+// int reads a n-byte integer.
+func (b *buffer) int(n int) (int, error) {
+	d, err := b.bytes(n)
+	if err != nil {
+		return 0, err
+	}
+	return byteToInt(d), nil
+}
+
+// skip n bytes.
+func (b *buffer) skip(n int) error {
+	_, err := b.bytes(n)
+	return err
+}
+
+// pad skips the padding after a value of n bytes, which is aligned to 4 bytes.
+// Some writers don't include the padding after the last value, so this is not
+// an error.
+func (b *buffer) pad(n int) {
+	p := -n & 3
+	if p > b.len() {
+		p = b.len()
+	}
+	b.off += p
+}
@@ -0,0 +1,143 @@
+package tnef
+
+import (
+	"io"
+	"strings"
+)
+
+// WriteVCard writes the contact as a vCard 4.0 (RFC 6350).
+//
+// Email addresses that aren't SMTP addresses, such as Exchange addresses, are
+// skipped. Phone numbers are written as text, as they're not always valid tel:
+// URIs.
+func (c *Contact) WriteVCard(w io.Writer) error {
+	cw := &contentWriter{w: w}
+	cw.prop("BEGIN", "VCARD")
+	cw.prop("VERSION", "4.0")
+	cw.prop("PRODID", icalProdID)
+	if c.UID != "" {
+		cw.text("UID", c.UID)
+	}
+
+	// FN is required.
+	fn := c.DisplayName
+	if fn == "" {
+		fn = strings.TrimSpace(c.GivenName + " " + c.Surname)
+	}
+	cw.text("FN", fn)
+	if c.Surname != "" || c.GivenName != "" || c.MiddleName != "" || c.Prefix != "" || c.Suffix != "" {
+		cw.structured("N", []string{c.Surname, c.GivenName, c.MiddleName, c.Prefix, c.Suffix})
+	}
+	if c.Nickname != "" {
+		cw.text("NICKNAME", c.Nickname)
+	}
+	switch c.Gender {
+	case GenderFemale:
+		cw.prop("GENDER", "F")
+	case GenderMale:
+		cw.prop("GENDER", "M")
+	}
+	if !c.Birthday.IsZero() {
+		cw.prop("BDAY", icalDate(c.Birthday))
+	}
+	if !c.Anniversary.IsZero() {
+		cw.prop("ANNIVERSARY", icalDate(c.Anniversary))
+	}
+
+	if c.Company != "" || c.Department != "" {
+		if c.Department == "" {
+			cw.text("ORG", c.Company)
+		} else {
+			cw.structured("ORG", []string{c.Company, c.Department})
+		}
+	}
+	if c.Title != "" {
+		cw.text("TITLE", c.Title)
+	}
+	if c.Profession != "" {
+		cw.text("ROLE", c.Profession)
+	}
+
+	pref := true
+	for _, e := range c.Emails {
+		if !strings.Contains(e.Email, "@") {
+			continue
+		}
+		if pref {
+			cw.prop("EMAIL", e.Email, "PREF=1")
+			pref = false
+		} else {
+			cw.prop("EMAIL", e.Email)
+		}
+	}
+	for _, p := range c.Phones {
+		params := []string{"VALUE=text"}
+		if types := phoneTypes[p.Type]; len(types) > 0 {
+			params = append(params, paramList("TYPE", types...))
+		}
+		if p.Type == PhonePrimary {
+			params = append(params, "PREF=1")
+		}
+		cw.text("TEL", p.Number, params...)
+	}
+	for _, a := range c.Addresses {
+		// The second component is the "extended address", which isn't
+		// used.
+		cw.structured("ADR", []string{a.PostOfficeBox, "", a.Street, a.City, a.State, a.PostalCode, a.Country},
+			param("TYPE", addressTypes[a.Type]))
+	}
+
+	if c.HomePage != "" {
+		cw.prop("URL", c.HomePage, "TYPE=home")
+	}
+	if c.BusinessHomePage != "" {
+		cw.prop("URL", c.BusinessHomePage, "TYPE=work")
+	}
+	if c.Spouse != "" {
+		cw.text("RELATED", c.Spouse, "VALUE=text", "TYPE=spouse")
+	}
+	for _, child := range c.Children {
+		cw.text("RELATED", child, "VALUE=text", "TYPE=child")
+	}
+	// Outlook's extensions; vCard has no equivalent.
+	if c.Manager != "" {
+		cw.text("X-MS-MANAGER", c.Manager)
+	}
+	if c.Assistant != "" {
+		cw.text("X-MS-ASSISTANT", c.Assistant)
+	}
+	if c.Notes != "" {
+		cw.text("NOTE", c.Notes)
+	}
+
+	cw.prop("END", "VCARD")
+	return cw.err
+}
+
+// vCard TYPE parameters for the Phone* constants; there's no type for telex.
+var phoneTypes = map[int][]string{
+	PhonePrimary:     {"voice"},
+	PhoneBusiness:    {"work", "voice"},
+	PhoneBusiness2:   {"work", "voice"},
+	PhoneHome:        {"home", "voice"},
+	PhoneHome2:       {"home", "voice"},
+	PhoneMobile:      {"cell"},
+	PhoneCar:         {"voice"},
+	PhoneRadio:       {"voice"},
+	PhonePager:       {"pager"},
+	PhoneCallback:    {"voice"},
+	PhoneAssistant:   {"voice"},
+	PhoneOther:       {"voice"},
+	PhoneISDN:        {"voice"},
+	PhonePrimaryFax:  {"fax"},
+	PhoneBusinessFax: {"work", "fax"},
+	PhoneHomeFax:     {"home", "fax"},
+}
+
+// vCard TYPE parameters for the Address* constants; there's no type for other
+// addresses.
+var addressTypes = map[int]string{
+	AddressBusiness: "work",
+	AddressHome:     "home",
+	AddressOther:    "x-other",
+}